@@ -0,0 +1,34 @@
+package spotinst
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+)
+
+// doAPIRequest sends a request with the given body, which may be nil, through
+// the raw API client and returns the items of the response. It is only used
+// for endpoints spotinst-sdk-go itself calls, to send or decode fields its
+// types lack; callers name the SDK method that calls the endpoint.
+func doAPIRequest(ctx context.Context, spotinstClient *Client, method, path string,
+	params url.Values, body interface{}) ([]json.RawMessage, error) {
+	r := client.NewRequest(method, path)
+	r.Obj = body
+	for k, v := range params {
+		r.Params[k] = v
+	}
+
+	resp, err := client.RequireOK(spotinstClient.api.Do(ctx, r))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var rw client.Response
+	if err := client.DecodeBody(resp, &rw); err != nil {
+		return nil, err
+	}
+	return rw.Response.Items, nil
+}
@@ -0,0 +1,178 @@
+package commons
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// FieldChange describes the change of a single field in an update.
+type FieldChange struct {
+	// Field is the name of the Terraform field.
+	Field string `json:"field"`
+
+	// Old and New are the values of the field before and after the update.
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+
+	// APIPaths are the attributes of the API object the field changed, e.g.
+	// `compute.launchSpecification.subnetIds`.
+	APIPaths []string `json:"apiPaths,omitempty"`
+}
+
+// Changeset is the list of field changes of an update, in the order the field
+// handlers ran.
+type Changeset struct {
+	Changes []*FieldChange `json:"changes"`
+}
+
+// HasChanges reports whether any field changed. It is safe to call on a nil
+// changeset.
+func (cs *Changeset) HasChanges() bool {
+	return cs != nil && len(cs.Changes) > 0
+}
+
+// Fields returns the names of the changed fields.
+func (cs *Changeset) Fields() []string {
+	if cs == nil {
+		return nil
+	}
+	fields := make([]string, 0, len(cs.Changes))
+	for _, change := range cs.Changes {
+		fields = append(fields, change.Field)
+	}
+	return fields
+}
+
+// String returns a one line summary of the changed fields and the API
+// attributes they changed, e.g. `subnet_ids (compute.launchSpecification.subnetIds)`.
+func (cs *Changeset) String() string {
+	if !cs.HasChanges() {
+		return "no changes"
+	}
+	changes := make([]string, 0, len(cs.Changes))
+	for _, change := range cs.Changes {
+		if len(change.APIPaths) > 0 {
+			changes = append(changes, fmt.Sprintf("%s (%s)", change.Field, strings.Join(change.APIPaths, ", ")))
+		} else {
+			changes = append(changes, change.Field)
+		}
+	}
+	return strings.Join(changes, ", ")
+}
+
+// WrapError adds the changed fields to an error returned while applying the
+// changeset, so that a rejected update shows what it was trying to change.
+func (cs *Changeset) WrapError(err error) error {
+	if err == nil || !cs.HasChanges() {
+		return err
+	}
+	return fmt.Errorf("%v\nchanged fields: %s", err, cs.String())
+}
+
+// UpdateFields runs the update handlers of the changed fields against the
+// resource object and returns the resulting changeset. apiObject is the API
+// object the handlers build, it is compared before and after each handler to
+// record the API attributes the field changed. The changeset is logged with
+// sensitive values redacted.
+func (res *GenericResource) UpdateFields(
+	resourceObject interface{},
+	apiObject interface{},
+	resourceData *schema.ResourceData,
+	meta interface{}) (*Changeset, error) {
+
+	changeset := &Changeset{}
+	for _, field := range res.GetOrderedFields() {
+		if field.onUpdate == nil || !field.hasFieldChange(resourceData, meta) {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
+
+		before := toGenericJSON(apiObject)
+		if err := field.onUpdate(resourceObject, resourceData, meta); err != nil {
+			return nil, err
+		}
+
+		oldValue, newValue := resourceData.GetChange(field.fieldNameStr)
+		change := &FieldChange{
+			Field:    field.fieldNameStr,
+			Old:      changeValue(field, oldValue),
+			New:      changeValue(field, newValue),
+			APIPaths: diffJSONPaths("", before, toGenericJSON(apiObject)),
+		}
+		changeset.Changes = append(changeset.Changes, change)
+	}
+
+	if changeset.HasChanges() {
+		if json, err := ToJson(changeset); err == nil {
+			log.Printf("===> %s update changeset: %s", res.GetName(), json)
+		}
+	}
+	return changeset, nil
+}
+
+// changeValue converts a field value to a form suitable for logging.
+func changeValue(field *GenericField, value interface{}) interface{} {
+	for _, key := range field.sensitiveKeys {
+		if key == field.fieldNameStr {
+			return RedactedValue
+		}
+	}
+	if set, ok := value.(*schema.Set); ok {
+		return set.List()
+	}
+	return value
+}
+
+func toGenericJSON(object interface{}) interface{} {
+	var value interface{}
+	if bytes, err := json.Marshal(object); err == nil {
+		_ = json.Unmarshal(bytes, &value)
+	}
+	return value
+}
+
+// diffJSONPaths returns the paths of the attributes that differ between two
+// decoded JSON values. Lists are compared as a whole, and an attribute that
+// appears in only one of the values differs even when it is null.
+func diffJSONPaths(prefix string, before, after interface{}) []string {
+	beforeMap, beforeOK := before.(map[string]interface{})
+	afterMap, afterOK := after.(map[string]interface{})
+	if !beforeOK || !afterOK {
+		if reflect.DeepEqual(before, after) {
+			return nil
+		}
+		return []string{prefix}
+	}
+
+	keys := make(map[string]struct{}, len(beforeMap)+len(afterMap))
+	for k := range beforeMap {
+		keys[k] = struct{}{}
+	}
+	for k := range afterMap {
+		keys[k] = struct{}{}
+	}
+
+	var paths []string
+	for k := range keys {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		beforeValue, inBefore := beforeMap[k]
+		afterValue, inAfter := afterMap[k]
+		if inBefore != inAfter {
+			// An attribute set to null clears it.
+			paths = append(paths, path)
+			continue
+		}
+		paths = append(paths, diffJSONPaths(path, beforeValue, afterValue)...)
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -52,7 +52,7 @@ func (res *ElastigroupTerraformResource) OnRead(
 	egWrapper := NewElastigroupWrapper()
 	egWrapper.SetElastigroup(elastigroup)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -74,7 +74,7 @@ func (res *ElastigroupTerraformResource) OnCreate(
 
 	egWrapper := NewElastigroupWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -88,28 +88,19 @@ func (res *ElastigroupTerraformResource) OnCreate(
 
 func (res *ElastigroupTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *aws.Group, error) {
+	meta interface{}) (*Changeset, *aws.Group, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	egWrapper := NewElastigroupWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(egWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(egWrapper, egWrapper.GetElastigroup(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, egWrapper.GetElastigroup(), nil
+	return changeset, egWrapper.GetElastigroup(), nil
 }
 
 // Spotinst elastigroup must have a wrapper struct.
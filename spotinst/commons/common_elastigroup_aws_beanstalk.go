@@ -30,6 +30,10 @@ type ElastigroupAWSBeanstalkWrapper struct {
 	// Block devices states
 	StatusEphemeralBlockDeviceUpdated bool
 	StatusEbsBlockDeviceUpdated       bool
+
+	// MaintenanceStatus is the current Beanstalk maintenance status of the
+	// group, nil when it has not been read.
+	MaintenanceStatus *string
 }
 
 func NewElastigroupAWSBeanstalkResource(fieldsMap map[FieldName]*GenericField) *ElastigroupAWSBeanstalkTerraformResource {
@@ -57,7 +61,7 @@ func (res *ElastigroupAWSBeanstalkTerraformResource) OnCreate(
 	beanstalkGroupWrapper := NewElastigroupAWSBeanstalkWrapper()
 	beanstalkGroupWrapper.SetElastigroupAWSBeanstalk(importedGroup)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -72,6 +76,7 @@ func (res *ElastigroupAWSBeanstalkTerraformResource) OnCreate(
 
 func (res *ElastigroupAWSBeanstalkTerraformResource) OnRead(
 	elastigroup *aws.Group,
+	maintenanceStatus *string,
 	resourceData *schema.ResourceData,
 	meta interface{}) error {
 
@@ -81,8 +86,9 @@ func (res *ElastigroupAWSBeanstalkTerraformResource) OnRead(
 
 	beanstalkWrapper := NewElastigroupAWSBeanstalkWrapper()
 	beanstalkWrapper.SetElastigroupAWSBeanstalk(elastigroup)
+	beanstalkWrapper.MaintenanceStatus = maintenanceStatus
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -96,40 +102,19 @@ func (res *ElastigroupAWSBeanstalkTerraformResource) OnRead(
 
 func (res *ElastigroupAWSBeanstalkTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *aws.Group, error) {
+	meta interface{}) (*Changeset, *aws.Group, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 	beanstalkWrapper := NewElastigroupAWSBeanstalkWrapper()
-	hasChanged := false
 
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(beanstalkWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(beanstalkWrapper, beanstalkWrapper.GetElastigroupAWSBeanstalk(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, beanstalkWrapper.GetElastigroupAWSBeanstalk(), nil
-}
-
-func (res *ElastigroupAWSBeanstalkTerraformResource) MaintenanceState(
-	resourceData *schema.ResourceData,
-	meta interface{}) (string, error) {
-	op := "NONE"
-
-	if res.fields.fieldsMap["maintenance"] != nil {
-		op = resourceData.Get("maintenance").(string)
-		return op, nil
-	}
-	return op, nil
+	return changeset, beanstalkWrapper.GetElastigroupAWSBeanstalk(), nil
 }
 
 // Spotinst elastigroup must have a wrapper struct.
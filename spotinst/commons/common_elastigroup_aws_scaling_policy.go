@@ -0,0 +1,128 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+)
+
+const (
+	ElastigroupAWSScalingPolicyResourceName ResourceName = "spotinst_elastigroup_aws_scaling_policy"
+)
+
+var ElastigroupAWSScalingPolicyResource *ElastigroupAWSScalingPolicyTerraformResource
+
+type ElastigroupAWSScalingPolicyTerraformResource struct {
+	GenericResource
+}
+
+// ElastigroupScalingPolicyWrapper holds a single scaling policy together with
+// the group and the policy list (up, down or target) it belongs to.
+type ElastigroupScalingPolicyWrapper struct {
+	GroupID    *string
+	PolicyType *string
+
+	policy *aws.ScalingPolicy
+}
+
+func NewElastigroupAWSScalingPolicyResource(fieldsMap map[FieldName]*GenericField) *ElastigroupAWSScalingPolicyTerraformResource {
+	return &ElastigroupAWSScalingPolicyTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: ElastigroupAWSScalingPolicyResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+// OnCreate is called when creating a new resource block and returns a new wrapped scaling policy or an error.
+func (res *ElastigroupAWSScalingPolicyTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*ElastigroupScalingPolicyWrapper, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	spWrapper := NewElastigroupScalingPolicyWrapper()
+
+	for _, field := range res.fields.orderedFields {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(spWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return spWrapper, nil
+}
+
+// OnRead is called when reading an existing resource and throws an error if it is unable to do so.
+func (res *ElastigroupAWSScalingPolicyTerraformResource) OnRead(
+	groupID string,
+	policyType string,
+	policy *aws.ScalingPolicy,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	spWrapper := NewElastigroupScalingPolicyWrapper()
+	spWrapper.GroupID = &groupID
+	spWrapper.PolicyType = &policyType
+	spWrapper.SetScalingPolicy(policy)
+
+	for _, field := range res.fields.orderedFields {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(spWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnUpdate is called when updating an existing resource. The changed fields are
+// applied on top of the policy currently attached to the group, since the whole
+// policy is sent back as part of the group's policy list.
+func (res *ElastigroupAWSScalingPolicyTerraformResource) OnUpdate(
+	policy *aws.ScalingPolicy,
+	resourceData *schema.ResourceData,
+	meta interface{}) (*Changeset, *aws.ScalingPolicy, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	spWrapper := NewElastigroupScalingPolicyWrapper()
+	if policy != nil {
+		spWrapper.SetScalingPolicy(policy)
+	}
+
+	changeset, err := res.UpdateFields(spWrapper, spWrapper.GetScalingPolicy(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changeset, spWrapper.GetScalingPolicy(), nil
+}
+
+func NewElastigroupScalingPolicyWrapper() *ElastigroupScalingPolicyWrapper {
+	return &ElastigroupScalingPolicyWrapper{
+		policy: &aws.ScalingPolicy{},
+	}
+}
+
+func (spWrapper *ElastigroupScalingPolicyWrapper) GetScalingPolicy() *aws.ScalingPolicy {
+	return spWrapper.policy
+}
+
+func (spWrapper *ElastigroupScalingPolicyWrapper) SetScalingPolicy(policy *aws.ScalingPolicy) {
+	spWrapper.policy = policy
+}
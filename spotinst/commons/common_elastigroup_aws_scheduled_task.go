@@ -0,0 +1,125 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+)
+
+const (
+	ElastigroupAWSScheduledTaskResourceName ResourceName = "spotinst_elastigroup_aws_scheduled_task"
+)
+
+var ElastigroupAWSScheduledTaskResource *ElastigroupAWSScheduledTaskTerraformResource
+
+type ElastigroupAWSScheduledTaskTerraformResource struct {
+	GenericResource
+}
+
+// ElastigroupScheduledTaskWrapper holds a single scheduled task together with
+// the group it belongs to.
+type ElastigroupScheduledTaskWrapper struct {
+	GroupID *string
+
+	task *aws.Task
+}
+
+func NewElastigroupAWSScheduledTaskResource(fieldsMap map[FieldName]*GenericField) *ElastigroupAWSScheduledTaskTerraformResource {
+	return &ElastigroupAWSScheduledTaskTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: ElastigroupAWSScheduledTaskResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+// OnCreate is called when creating a new resource block and returns a new wrapped scheduled task or an error.
+func (res *ElastigroupAWSScheduledTaskTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*ElastigroupScheduledTaskWrapper, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	stWrapper := NewElastigroupScheduledTaskWrapper()
+
+	for _, field := range res.fields.orderedFields {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(stWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return stWrapper, nil
+}
+
+// OnRead is called when reading an existing resource and throws an error if it is unable to do so.
+func (res *ElastigroupAWSScheduledTaskTerraformResource) OnRead(
+	groupID string,
+	task *aws.Task,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	stWrapper := NewElastigroupScheduledTaskWrapper()
+	stWrapper.GroupID = &groupID
+	stWrapper.SetScheduledTask(task)
+
+	for _, field := range res.fields.orderedFields {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(stWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnUpdate is called when updating an existing resource. The changed fields are
+// applied on top of the task currently attached to the group, since the whole
+// task is sent back as part of the group's task list.
+func (res *ElastigroupAWSScheduledTaskTerraformResource) OnUpdate(
+	task *aws.Task,
+	resourceData *schema.ResourceData,
+	meta interface{}) (*Changeset, *aws.Task, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	stWrapper := NewElastigroupScheduledTaskWrapper()
+	if task != nil {
+		stWrapper.SetScheduledTask(task)
+	}
+
+	changeset, err := res.UpdateFields(stWrapper, stWrapper.GetScheduledTask(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changeset, stWrapper.GetScheduledTask(), nil
+}
+
+func NewElastigroupScheduledTaskWrapper() *ElastigroupScheduledTaskWrapper {
+	return &ElastigroupScheduledTaskWrapper{
+		task: &aws.Task{},
+	}
+}
+
+func (stWrapper *ElastigroupScheduledTaskWrapper) GetScheduledTask() *aws.Task {
+	return stWrapper.task
+}
+
+func (stWrapper *ElastigroupScheduledTaskWrapper) SetScheduledTask(task *aws.Task) {
+	stWrapper.task = task
+}
@@ -0,0 +1,133 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	ElastigroupAWSStatefulInstanceStateResourceName ResourceName = "spotinst_elastigroup_aws_stateful_instance_state"
+	ElastigroupAWSStatefulInstancesDataSourceName   ResourceName = "spotinst_elastigroup_aws_stateful_instances"
+)
+
+var ElastigroupAWSStatefulInstanceStateResource *ElastigroupAWSStatefulInstanceStateTerraformResource
+
+type ElastigroupAWSStatefulInstanceStateTerraformResource struct {
+	GenericResource
+}
+
+// ElastigroupAWSStatefulInstanceStateSpec is the desired state of a stateful
+// instance of an Elastigroup. It is not an API object, the state is converged
+// to by pausing or resuming the stateful instance.
+type ElastigroupAWSStatefulInstanceStateSpec struct {
+	ElastigroupID      *string `json:"elastigroupId,omitempty"`
+	StatefulInstanceID *string `json:"statefulInstanceId,omitempty"`
+	State              *string `json:"state,omitempty"`
+
+	// Timeout is in seconds.
+	Timeout *int `json:"timeout,omitempty"`
+
+	// Status, InstanceID and PrivateIP are the current state of the stateful
+	// instance and of the instance running it.
+	Status     *string `json:"status,omitempty"`
+	InstanceID *string `json:"instanceId,omitempty"`
+	PrivateIP  *string `json:"privateIp,omitempty"`
+}
+
+type ElastigroupAWSStatefulInstanceStateWrapper struct {
+	state *ElastigroupAWSStatefulInstanceStateSpec
+}
+
+func NewElastigroupAWSStatefulInstanceStateResource(fieldMap map[FieldName]*GenericField) *ElastigroupAWSStatefulInstanceStateTerraformResource {
+	return &ElastigroupAWSStatefulInstanceStateTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: ElastigroupAWSStatefulInstanceStateResourceName,
+			fields:       NewGenericFields(fieldMap),
+		},
+	}
+}
+
+func (res *ElastigroupAWSStatefulInstanceStateTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*ElastigroupAWSStatefulInstanceStateSpec, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	stateWrapper := NewElastigroupAWSStatefulInstanceStateWrapper()
+
+	for _, field := range res.fields.orderedFields {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(stateWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return stateWrapper.GetElastigroupAWSStatefulInstanceState(), nil
+}
+
+func (res *ElastigroupAWSStatefulInstanceStateTerraformResource) OnRead(
+	state *ElastigroupAWSStatefulInstanceStateSpec,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	stateWrapper := NewElastigroupAWSStatefulInstanceStateWrapper()
+	stateWrapper.SetElastigroupAWSStatefulInstanceState(state)
+
+	for _, field := range res.fields.orderedFields {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(stateWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (res *ElastigroupAWSStatefulInstanceStateTerraformResource) OnUpdate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*Changeset, *ElastigroupAWSStatefulInstanceStateSpec, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	stateWrapper := NewElastigroupAWSStatefulInstanceStateWrapper()
+	changeset, err := res.UpdateFields(stateWrapper, stateWrapper.GetElastigroupAWSStatefulInstanceState(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	state, err := res.OnCreate(resourceData, meta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changeset, state, nil
+}
+
+func NewElastigroupAWSStatefulInstanceStateWrapper() *ElastigroupAWSStatefulInstanceStateWrapper {
+	return &ElastigroupAWSStatefulInstanceStateWrapper{
+		state: &ElastigroupAWSStatefulInstanceStateSpec{},
+	}
+}
+
+func (stateWrapper *ElastigroupAWSStatefulInstanceStateWrapper) GetElastigroupAWSStatefulInstanceState() *ElastigroupAWSStatefulInstanceStateSpec {
+	return stateWrapper.state
+}
+
+func (stateWrapper *ElastigroupAWSStatefulInstanceStateWrapper) SetElastigroupAWSStatefulInstanceState(state *ElastigroupAWSStatefulInstanceStateSpec) {
+	stateWrapper.state = state
+}
@@ -42,7 +42,7 @@ func (res *SuspendProcessesTerraformResource) OnCreate(
 	}
 
 	spWrapper := NewSuspendProcessesWrapper()
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -67,7 +67,7 @@ func (res *SuspendProcessesTerraformResource) OnRead(
 	spWrapper := NewSuspendProcessesWrapper()
 	spWrapper.SetSuspendProcesses(suspendProcesses)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -83,27 +83,18 @@ func (res *SuspendProcessesTerraformResource) OnRead(
 // a suspedProcesses with a bool indicating if had been updated, or an error.
 func (res *SuspendProcessesTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *aws.SuspendProcesses, error) {
+	meta interface{}) (*Changeset, *aws.SuspendProcesses, error) {
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	spWrapper := NewSuspendProcessesWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(spWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(spWrapper, spWrapper.GetSuspendProcesses().SuspendProcesses, resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, spWrapper.GetSuspendProcesses().SuspendProcesses, nil
+	return changeset, spWrapper.GetSuspendProcesses().SuspendProcesses, nil
 }
 
 // NewsuspendProcessesWrapper avoids parameter collisions and returns a SuspendProcesses.
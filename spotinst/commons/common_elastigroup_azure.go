@@ -43,7 +43,7 @@ func (res *ElastigroupAzureTerraformResource) OnRead(
 	egWrapper := NewElastigroupAzureWrapper()
 	egWrapper.SetElastigroup(elastigroup)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -65,7 +65,7 @@ func (res *ElastigroupAzureTerraformResource) OnCreate(
 
 	egWrapper := NewElastigroupAzureWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -79,28 +79,19 @@ func (res *ElastigroupAzureTerraformResource) OnCreate(
 
 func (res *ElastigroupAzureTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *azure.Group, error) {
+	meta interface{}) (*Changeset, *azure.Group, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	egWrapper := NewElastigroupAzureWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(egWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(egWrapper, egWrapper.GetElastigroup(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, egWrapper.GetElastigroup(), nil
+	return changeset, egWrapper.GetElastigroup(), nil
 }
 
 // Spotinst elastigroup must have a wrapper struct.
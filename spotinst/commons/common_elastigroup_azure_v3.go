@@ -44,7 +44,7 @@ func (res *ElastigroupAzureV3TerraformResource) OnRead(
 	egWrapper := NewElastigroupAzureV3Wrapper()
 	egWrapper.SetElastigroup(elastigroup)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -66,7 +66,7 @@ func (res *ElastigroupAzureV3TerraformResource) OnCreate(
 
 	egWrapper := NewElastigroupAzureV3Wrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -80,28 +80,19 @@ func (res *ElastigroupAzureV3TerraformResource) OnCreate(
 
 func (res *ElastigroupAzureV3TerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *azurev3.Group, error) {
+	meta interface{}) (*Changeset, *azurev3.Group, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	egWrapper := NewElastigroupAzureV3Wrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(egWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(egWrapper, egWrapper.GetElastigroup(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, egWrapper.GetElastigroup(), nil
+	return changeset, egWrapper.GetElastigroup(), nil
 }
 
 // Spotinst elastigroup must have a wrapper struct.
@@ -43,7 +43,7 @@ func (res *ElastigroupGCPTerraformResource) OnCreate(
 
 	egWrapper := NewElastigroupGCPWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -68,7 +68,7 @@ func (res *ElastigroupGCPTerraformResource) OnRead(
 	egWrapper := NewElastigroupGCPWrapper()
 	egWrapper.SetElastigroup(elastigroup)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -84,27 +84,18 @@ func (res *ElastigroupGCPTerraformResource) OnRead(
 // an elastigroup with a bool indicating if had been updated, or an error.
 func (res *ElastigroupGCPTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *gcp.Group, error) {
+	meta interface{}) (*Changeset, *gcp.Group, error) {
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	egWrapper := NewElastigroupGCPWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(egWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(egWrapper, egWrapper.GetElastigroup(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, egWrapper.GetElastigroup(), nil
+	return changeset, egWrapper.GetElastigroup(), nil
 }
 
 // NewElastigroupGCPWrapper avoids parameter collisions and returns a GCP Elastigroup.
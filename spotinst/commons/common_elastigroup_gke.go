@@ -82,7 +82,7 @@ func (res *ElastigroupGKETerraformResource) OnCreate(
 
 	egWrapper := NewElastigroupGKEWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -107,7 +107,7 @@ func (res *ElastigroupGKETerraformResource) OnMerge(
 	egWrapper := NewElastigroupGKEWrapper()
 	egWrapper.SetElastigroup(importedGroup)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -133,7 +133,7 @@ func (res *ElastigroupGKETerraformResource) OnRead(
 	gkeGroupWrapper := NewElastigroupGKEWrapper()
 	gkeGroupWrapper.SetElastigroup(elastigroup)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -149,27 +149,18 @@ func (res *ElastigroupGKETerraformResource) OnRead(
 // an elastigroup with a bool indicating if had been updated, or an error.
 func (res *ElastigroupGKETerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *gcp.Group, error) {
+	meta interface{}) (*Changeset, *gcp.Group, error) {
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	egWrapper := NewElastigroupGKEWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(egWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(egWrapper, egWrapper.GetElastigroup(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, egWrapper.GetElastigroup(), nil
+	return changeset, egWrapper.GetElastigroup(), nil
 }
 
 // NewElastigroupGKEWrapper avoids parameter collisions and returns a GKE Elastigroup.
@@ -9,7 +9,8 @@ import (
 )
 
 const (
-	HealthCheckResourceName ResourceName = "spotinst_health_check"
+	HealthCheckResourceName    ResourceName = "spotinst_health_check"
+	HealthChecksDataSourceName ResourceName = "spotinst_health_checks"
 )
 
 var HealthCheckResource *HealthCheckTerraformResource
@@ -20,6 +21,40 @@ type HealthCheckTerraformResource struct {
 
 type HealthCheckWrapper struct {
 	healthCheck *healthcheck.HealthCheck
+
+	// CheckOptions holds the check fields that healthcheck.Check does not
+	// support yet, they are sent along with the check.
+	CheckOptions *HealthCheckOptions
+
+	// InstancesHealth is the current health of the instances of the checked
+	// resource, nil when it is not reported for the resource.
+	InstancesHealth *HealthCheckInstancesHealth
+}
+
+// HealthCheckInstancesHealth holds the number of healthy and unhealthy
+// instances of the resource a health check belongs to.
+type HealthCheckInstancesHealth struct {
+	Healthy   int
+	Unhealthy int
+}
+
+// HealthCheckOptions holds the custom request headers, the expected response
+// body and the grace period of a check.
+type HealthCheckOptions struct {
+	Headers      []*HealthCheckHeader `json:"headers"`
+	ExpectedBody *string              `json:"expectedResponseBody"`
+	GracePeriod  *int                 `json:"gracePeriod"`
+}
+
+// IsEmpty reports whether no check option is set.
+func (o *HealthCheckOptions) IsEmpty() bool {
+	return o == nil || (len(o.Headers) == 0 && o.ExpectedBody == nil && o.GracePeriod == nil)
+}
+
+// HealthCheckHeader is a request header sent by HTTP and HTTPS checks.
+type HealthCheckHeader struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
 }
 
 // NewHealthCheckResource creates a new HealthCheck resource
@@ -32,31 +67,33 @@ func NewHealthCheckResource(fieldMap map[FieldName]*GenericField) *HealthCheckTe
 	}
 }
 
-// OnCreate is called when creating a new resource block and returns a new HealthCheck or an error.
+// OnCreate is called when creating a new resource block and returns a new
+// HealthCheck with its check options, or an error.
 func (res *HealthCheckTerraformResource) OnCreate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (*healthcheck.HealthCheck, error) {
+	meta interface{}) (*healthcheck.HealthCheck, *HealthCheckOptions, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot create")
 	}
 
 	hcWrapper := NewHealthCheckWrapper()
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
 		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
 		if err := field.onCreate(hcWrapper, resourceData, meta); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
-	return hcWrapper.GetHealthCheck(), nil
+	return hcWrapper.GetHealthCheck(), hcWrapper.CheckOptions, nil
 }
 
 // OnRead is called when reading an existing resource and throws an error if it is unable to do so.
 func (res *HealthCheckTerraformResource) OnRead(
 	healthCheck *healthcheck.HealthCheck,
+	instancesHealth *HealthCheckInstancesHealth,
 	resourceData *schema.ResourceData,
 	meta interface{}) error {
 
@@ -66,8 +103,9 @@ func (res *HealthCheckTerraformResource) OnRead(
 
 	hcWrapper := NewHealthCheckWrapper()
 	hcWrapper.SetHealthCheck(healthCheck)
+	hcWrapper.InstancesHealth = instancesHealth
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -80,30 +118,22 @@ func (res *HealthCheckTerraformResource) OnRead(
 }
 
 // OnUpdate is called when updating an existing resource and returns
-// an healthCheck with a bool indicating if had been updated, or an error.
+// an healthCheck and its check options with a bool indicating if had been
+// updated, or an error.
 func (res *HealthCheckTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *healthcheck.HealthCheck, error) {
+	meta interface{}) (*Changeset, *healthcheck.HealthCheck, *HealthCheckOptions, error) {
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	hcWrapper := NewHealthCheckWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(hcWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(hcWrapper, hcWrapper.GetHealthCheck(), resourceData, meta)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	return hasChanged, hcWrapper.GetHealthCheck(), nil
+	return changeset, hcWrapper.GetHealthCheck(), hcWrapper.CheckOptions, nil
 }
 
 // NewElastigroupGCPWrapper avoids parameter collisions and returns a HealthCheck.
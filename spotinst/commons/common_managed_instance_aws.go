@@ -29,6 +29,10 @@ type MangedInstanceAWSWrapper struct {
 	// Block devices states
 	StatusEphemeralBlockDeviceUpdated bool
 	StatusEbsBlockDeviceUpdated       bool
+
+	// InstanceStatus is the current status of the underlying instance, nil
+	// when it has not been read.
+	InstanceStatus *aws.StatusManagedInstanceOutput
 }
 
 func NewManagedInstanceResource(fieldsMap map[FieldName]*GenericField) *ManagedInstanceTerraformResource {
@@ -42,6 +46,7 @@ func NewManagedInstanceResource(fieldsMap map[FieldName]*GenericField) *ManagedI
 
 func (res *ManagedInstanceTerraformResource) OnRead(
 	managedInstance *aws.ManagedInstance,
+	instanceStatus *aws.StatusManagedInstanceOutput,
 	resourceData *schema.ResourceData,
 	meta interface{}) error {
 
@@ -50,8 +55,9 @@ func (res *ManagedInstanceTerraformResource) OnRead(
 	}
 	miWrapper := NewManagedInstanceWrapper()
 	miWrapper.SetManagedInstance(managedInstance)
+	miWrapper.InstanceStatus = instanceStatus
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -72,7 +78,7 @@ func (res *ManagedInstanceTerraformResource) OnCreate(
 	}
 	miWrapper := NewManagedInstanceWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -86,27 +92,18 @@ func (res *ManagedInstanceTerraformResource) OnCreate(
 
 func (res *ManagedInstanceTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *aws.ManagedInstance, error) {
+	meta interface{}) (*Changeset, *aws.ManagedInstance, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 	miWrapper := NewManagedInstanceWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(miWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(miWrapper, miWrapper.GetManagedInstance(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, miWrapper.GetManagedInstance(), nil
+	return changeset, miWrapper.GetManagedInstance(), nil
 }
 
 func NewManagedInstanceWrapper() *MangedInstanceAWSWrapper {
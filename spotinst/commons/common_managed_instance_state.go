@@ -0,0 +1,128 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	ManagedInstanceStateResourceName ResourceName = "spotinst_managed_instance_state"
+)
+
+var ManagedInstanceStateResource *ManagedInstanceStateTerraformResource
+
+type ManagedInstanceStateTerraformResource struct {
+	GenericResource
+}
+
+// ManagedInstanceStateSpec is the desired state of a managed instance. It is
+// not an API object, the state is converged to by pausing or resuming the
+// managed instance.
+type ManagedInstanceStateSpec struct {
+	ManagedInstanceID *string `json:"managedInstanceId,omitempty"`
+	State             *string `json:"state,omitempty"`
+
+	// Timeout is in seconds.
+	Timeout *int `json:"timeout,omitempty"`
+
+	// Status is the current status of the managed instance.
+	Status *string `json:"status,omitempty"`
+}
+
+type ManagedInstanceStateWrapper struct {
+	state *ManagedInstanceStateSpec
+}
+
+func NewManagedInstanceStateResource(fieldMap map[FieldName]*GenericField) *ManagedInstanceStateTerraformResource {
+	return &ManagedInstanceStateTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: ManagedInstanceStateResourceName,
+			fields:       NewGenericFields(fieldMap),
+		},
+	}
+}
+
+func (res *ManagedInstanceStateTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*ManagedInstanceStateSpec, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	stateWrapper := NewManagedInstanceStateWrapper()
+
+	for _, field := range res.fields.orderedFields {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(stateWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return stateWrapper.GetManagedInstanceState(), nil
+}
+
+func (res *ManagedInstanceStateTerraformResource) OnRead(
+	state *ManagedInstanceStateSpec,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	stateWrapper := NewManagedInstanceStateWrapper()
+	stateWrapper.SetManagedInstanceState(state)
+
+	for _, field := range res.fields.orderedFields {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(stateWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (res *ManagedInstanceStateTerraformResource) OnUpdate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*Changeset, *ManagedInstanceStateSpec, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	stateWrapper := NewManagedInstanceStateWrapper()
+	changeset, err := res.UpdateFields(stateWrapper, stateWrapper.GetManagedInstanceState(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	state, err := res.OnCreate(resourceData, meta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changeset, state, nil
+}
+
+func NewManagedInstanceStateWrapper() *ManagedInstanceStateWrapper {
+	return &ManagedInstanceStateWrapper{
+		state: &ManagedInstanceStateSpec{},
+	}
+}
+
+func (stateWrapper *ManagedInstanceStateWrapper) GetManagedInstanceState() *ManagedInstanceStateSpec {
+	return stateWrapper.state
+}
+
+func (stateWrapper *ManagedInstanceStateWrapper) SetManagedInstanceState(state *ManagedInstanceStateSpec) {
+	stateWrapper.state = state
+}
@@ -9,7 +9,8 @@ import (
 )
 
 const (
-	MRScalerAWSResourceName ResourceName = "spotinst_mrscaler_aws"
+	MRScalerAWSResourceName   ResourceName = "spotinst_mrscaler_aws"
+	MRScalerAWSDataSourceName ResourceName = "spotinst_mrscaler_aws"
 )
 
 var MRScalerAWSResource *MRScalerAWSTerraformResource
@@ -20,6 +21,31 @@ type MRScalerAWSTerraformResource struct {
 
 type MRScalerAWSWrapper struct {
 	mrscaler *mrscaler.Scaler
+
+	// Cluster is the current state of the EMR cluster of the scaler, nil
+	// when it is not available.
+	Cluster *MRScalerAWSClusterStatus
+}
+
+// MRScalerAWSClusterStatus is the current state of the EMR cluster managed by
+// a scaler. The mrscaler service of spotinst-sdk-go only decodes its ID.
+type MRScalerAWSClusterStatus struct {
+	ID                  *string                            `json:"id,omitempty"`
+	State               *string                            `json:"state,omitempty"`
+	MasterPublicDNSName *string                            `json:"masterPublicDnsName,omitempty"`
+	InstanceGroups      []*MRScalerAWSClusterInstanceGroup `json:"instanceGroups,omitempty"`
+	Steps               []*MRScalerAWSClusterStep          `json:"steps,omitempty"`
+}
+
+type MRScalerAWSClusterInstanceGroup struct {
+	ID   *string `json:"id,omitempty"`
+	Type *string `json:"instanceGroupType,omitempty"`
+}
+
+type MRScalerAWSClusterStep struct {
+	ID     *string `json:"id,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	Status *string `json:"status,omitempty"`
 }
 
 func NewMRScalerAWSResource(fieldsMap map[FieldName]*GenericField) *MRScalerAWSTerraformResource {
@@ -41,7 +67,7 @@ func (res *MRScalerAWSTerraformResource) OnCreate(
 
 	mrsWrapper := NewMRScalerAWSWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -55,6 +81,7 @@ func (res *MRScalerAWSTerraformResource) OnCreate(
 
 func (res *MRScalerAWSTerraformResource) OnRead(
 	mrscaler *mrscaler.Scaler,
+	cluster *MRScalerAWSClusterStatus,
 	resourceData *schema.ResourceData,
 	meta interface{}) error {
 
@@ -64,8 +91,9 @@ func (res *MRScalerAWSTerraformResource) OnRead(
 
 	mrsWrapper := NewMRScalerAWSWrapper()
 	mrsWrapper.SetMRScalerAWS(mrscaler)
+	mrsWrapper.Cluster = cluster
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -79,28 +107,19 @@ func (res *MRScalerAWSTerraformResource) OnRead(
 
 func (res *MRScalerAWSTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *mrscaler.Scaler, error) {
+	meta interface{}) (*Changeset, *mrscaler.Scaler, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	mrsWrapper := NewMRScalerAWSWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(mrsWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(mrsWrapper, mrsWrapper.GetMRScalerAWS(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, mrsWrapper.GetMRScalerAWS(), nil
+	return changeset, mrsWrapper.GetMRScalerAWS(), nil
 }
 
 func NewMRScalerAWSWrapper() *MRScalerAWSWrapper {
@@ -9,7 +9,8 @@ import (
 )
 
 const (
-	MultaiBalancerResourceName ResourceName = "spotinst_multai_balancer"
+	MultaiBalancerResourceName   ResourceName = "spotinst_multai_balancer"
+	MultaiBalancerDataSourceName ResourceName = "spotinst_multai_balancer"
 )
 
 var MultaiBalancerResource *MultaiBalancerTerraformResource
@@ -41,7 +42,7 @@ func (res *MultaiBalancerTerraformResource) OnCreate(
 
 	mlbWrapper := NewMultaiBalancerWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -65,7 +66,7 @@ func (res *MultaiBalancerTerraformResource) OnRead(
 	mlbWrapper := NewMultaiBalancerWrapper()
 	mlbWrapper.SetMultaiBalancer(balancer)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -80,28 +81,19 @@ func (res *MultaiBalancerTerraformResource) OnRead(
 
 func (res *MultaiBalancerTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *multai.LoadBalancer, error) {
+	meta interface{}) (*Changeset, *multai.LoadBalancer, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	mlbWrapper := NewMultaiBalancerWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(mlbWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(mlbWrapper, mlbWrapper.GetMultaiBalancer(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, mlbWrapper.GetMultaiBalancer(), nil
+	return changeset, mlbWrapper.GetMultaiBalancer(), nil
 }
 
 func NewMultaiBalancerWrapper() *MultaiBalancerWrapper {
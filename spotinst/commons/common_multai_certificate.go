@@ -0,0 +1,110 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+)
+
+const (
+	MultaiCertificateResourceName ResourceName = "spotinst_multai_certificate"
+)
+
+var MultaiCertificateResource *MultaiCertificateTerraformResource
+
+type MultaiCertificateTerraformResource struct {
+	GenericResource
+}
+
+type MultaiCertificateWrapper struct {
+	certificate *multai.Certificate
+}
+
+func NewMultaiCertificateResource(fieldMap map[FieldName]*GenericField) *MultaiCertificateTerraformResource {
+	return &MultaiCertificateTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: MultaiCertificateResourceName,
+			fields:       NewGenericFields(fieldMap),
+		},
+	}
+}
+
+func (res *MultaiCertificateTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*multai.Certificate, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	certificateWrapper := NewMultaiCertificateWrapper()
+
+	for _, field := range res.fields.orderedFields {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(certificateWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return certificateWrapper.GetMultaiCertificate(), nil
+}
+
+func (res *MultaiCertificateTerraformResource) OnRead(
+	certificate *multai.Certificate,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	certificateWrapper := NewMultaiCertificateWrapper()
+	certificateWrapper.SetMultaiCertificate(certificate)
+
+	for _, field := range res.fields.orderedFields {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(certificateWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (res *MultaiCertificateTerraformResource) OnUpdate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*Changeset, *multai.Certificate, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	certificateWrapper := NewMultaiCertificateWrapper()
+	changeset, err := res.UpdateFields(certificateWrapper, certificateWrapper.GetMultaiCertificate(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changeset, certificateWrapper.GetMultaiCertificate(), nil
+}
+
+func NewMultaiCertificateWrapper() *MultaiCertificateWrapper {
+	return &MultaiCertificateWrapper{
+		certificate: &multai.Certificate{},
+	}
+}
+
+func (certificateWrapper *MultaiCertificateWrapper) GetMultaiCertificate() *multai.Certificate {
+	return certificateWrapper.certificate
+}
+
+func (certificateWrapper *MultaiCertificateWrapper) SetMultaiCertificate(certificate *multai.Certificate) {
+	certificateWrapper.certificate = certificate
+}
@@ -9,7 +9,8 @@ import (
 )
 
 const (
-	MultaiDeploymentResourceName ResourceName = "spotinst_multai_deployment"
+	MultaiDeploymentResourceName   ResourceName = "spotinst_multai_deployment"
+	MultaiDeploymentDataSourceName ResourceName = "spotinst_multai_deployment"
 )
 
 var MultaiDeploymentResource *MultaiDeploymentTerraformResource
@@ -41,7 +42,7 @@ func (res *MultaiDeploymentTerraformResource) OnCreate(
 
 	mlbWrapper := NewMultaiDeploymentWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -65,7 +66,7 @@ func (res *MultaiDeploymentTerraformResource) OnRead(
 	mlbWrapper := NewMultaiDeploymentWrapper()
 	mlbWrapper.SetMultaiDeployment(deployment)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -80,28 +81,19 @@ func (res *MultaiDeploymentTerraformResource) OnRead(
 
 func (res *MultaiDeploymentTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *multai.Deployment, error) {
+	meta interface{}) (*Changeset, *multai.Deployment, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	mlbWrapper := NewMultaiDeploymentWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(mlbWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(mlbWrapper, mlbWrapper.GetMultaiDeployment(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, mlbWrapper.GetMultaiDeployment(), nil
+	return changeset, mlbWrapper.GetMultaiDeployment(), nil
 }
 
 func NewMultaiDeploymentWrapper() *MultaiDeploymentWrapper {
@@ -9,7 +9,8 @@ import (
 )
 
 const (
-	MultaiListenerResourceName ResourceName = "spotinst_multai_listener"
+	MultaiListenerResourceName    ResourceName = "spotinst_multai_listener"
+	MultaiListenersDataSourceName ResourceName = "spotinst_multai_listeners"
 )
 
 var MultaiListenerResource *MultaiListenerTerraformResource
@@ -41,7 +42,7 @@ func (res *MultaiListenerTerraformResource) OnCreate(
 
 	mlbWrapper := NewMultaiListenerWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -65,7 +66,7 @@ func (res *MultaiListenerTerraformResource) OnRead(
 	mlbWrapper := NewMultaiListenerWrapper()
 	mlbWrapper.SetMultaiListener(listener)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -80,28 +81,19 @@ func (res *MultaiListenerTerraformResource) OnRead(
 
 func (res *MultaiListenerTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *multai.Listener, error) {
+	meta interface{}) (*Changeset, *multai.Listener, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	mlbWrapper := NewMultaiListenerWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(mlbWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(mlbWrapper, mlbWrapper.GetMultaiListener(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, mlbWrapper.GetMultaiListener(), nil
+	return changeset, mlbWrapper.GetMultaiListener(), nil
 }
 
 func NewMultaiListenerWrapper() *MultaiListenerWrapper {
@@ -0,0 +1,110 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+)
+
+const (
+	MultaiMiddlewareResourceName ResourceName = "spotinst_multai_middleware"
+)
+
+var MultaiMiddlewareResource *MultaiMiddlewareTerraformResource
+
+type MultaiMiddlewareTerraformResource struct {
+	GenericResource
+}
+
+type MultaiMiddlewareWrapper struct {
+	middleware *multai.Middleware
+}
+
+func NewMultaiMiddlewareResource(fieldMap map[FieldName]*GenericField) *MultaiMiddlewareTerraformResource {
+	return &MultaiMiddlewareTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: MultaiMiddlewareResourceName,
+			fields:       NewGenericFields(fieldMap),
+		},
+	}
+}
+
+func (res *MultaiMiddlewareTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*multai.Middleware, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	middlewareWrapper := NewMultaiMiddlewareWrapper()
+
+	for _, field := range res.fields.orderedFields {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(middlewareWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return middlewareWrapper.GetMultaiMiddleware(), nil
+}
+
+func (res *MultaiMiddlewareTerraformResource) OnRead(
+	middleware *multai.Middleware,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	middlewareWrapper := NewMultaiMiddlewareWrapper()
+	middlewareWrapper.SetMultaiMiddleware(middleware)
+
+	for _, field := range res.fields.orderedFields {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(middlewareWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (res *MultaiMiddlewareTerraformResource) OnUpdate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*Changeset, *multai.Middleware, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	middlewareWrapper := NewMultaiMiddlewareWrapper()
+	changeset, err := res.UpdateFields(middlewareWrapper, middlewareWrapper.GetMultaiMiddleware(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changeset, middlewareWrapper.GetMultaiMiddleware(), nil
+}
+
+func NewMultaiMiddlewareWrapper() *MultaiMiddlewareWrapper {
+	return &MultaiMiddlewareWrapper{
+		middleware: &multai.Middleware{},
+	}
+}
+
+func (middlewareWrapper *MultaiMiddlewareWrapper) GetMultaiMiddleware() *multai.Middleware {
+	return middlewareWrapper.middleware
+}
+
+func (middlewareWrapper *MultaiMiddlewareWrapper) SetMultaiMiddleware(middleware *multai.Middleware) {
+	middlewareWrapper.middleware = middleware
+}
@@ -41,7 +41,7 @@ func (res *MultaiRoutingRuleTerraformResource) OnCreate(
 
 	mlbWrapper := NewMultaiRoutingRuleWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -65,7 +65,7 @@ func (res *MultaiRoutingRuleTerraformResource) OnRead(
 	mlbWrapper := NewMultaiRoutingRuleWrapper()
 	mlbWrapper.SetMultaiRoutingRule(routingRule)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -80,28 +80,19 @@ func (res *MultaiRoutingRuleTerraformResource) OnRead(
 
 func (res *MultaiRoutingRuleTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *multai.RoutingRule, error) {
+	meta interface{}) (*Changeset, *multai.RoutingRule, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	mlbWrapper := NewMultaiRoutingRuleWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(mlbWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(mlbWrapper, mlbWrapper.GetMultaiRoutingRule(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, mlbWrapper.GetMultaiRoutingRule(), nil
+	return changeset, mlbWrapper.GetMultaiRoutingRule(), nil
 }
 
 func NewMultaiRoutingRuleWrapper() *MultaiRoutingRuleWrapper {
@@ -41,7 +41,7 @@ func (res *MultaiTargetTerraformResource) OnCreate(
 
 	targetWrapper := NewMultaiTargetWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -65,7 +65,7 @@ func (res *MultaiTargetTerraformResource) OnRead(
 	targetWrapper := NewMultaiTargetWrapper()
 	targetWrapper.SetMultaiTarget(target)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -80,28 +80,19 @@ func (res *MultaiTargetTerraformResource) OnRead(
 
 func (res *MultaiTargetTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *multai.Target, error) {
+	meta interface{}) (*Changeset, *multai.Target, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	targetWrapper := NewMultaiTargetWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(targetWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(targetWrapper, targetWrapper.GetMultaiTarget(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, targetWrapper.GetMultaiTarget(), nil
+	return changeset, targetWrapper.GetMultaiTarget(), nil
 }
 
 func NewMultaiTargetWrapper() *MultaiTargetWrapper {
@@ -9,7 +9,8 @@ import (
 )
 
 const (
-	MultaiTargetSetResourceName ResourceName = "spotinst_multai_target_set"
+	MultaiTargetSetResourceName    ResourceName = "spotinst_multai_target_set"
+	MultaiTargetSetsDataSourceName ResourceName = "spotinst_multai_target_sets"
 )
 
 var MultaiTargetSetResource *MultaiTargetSetTerraformResource
@@ -41,7 +42,7 @@ func (res *MultaiTargetSetTerraformResource) OnCreate(
 
 	targetSetWrapper := NewMultaiTargetSetWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -65,7 +66,7 @@ func (res *MultaiTargetSetTerraformResource) OnRead(
 	targetSetWrapper := NewMultaiTargetSetWrapper()
 	targetSetWrapper.SetMultaiTargetSet(targetSet)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -80,28 +81,19 @@ func (res *MultaiTargetSetTerraformResource) OnRead(
 
 func (res *MultaiTargetSetTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *multai.TargetSet, error) {
+	meta interface{}) (*Changeset, *multai.TargetSet, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	targetSetWrapper := NewMultaiTargetSetWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(targetSetWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(targetSetWrapper, targetSetWrapper.GetMultaiTargetSet(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, targetSetWrapper.GetMultaiTargetSet(), nil
+	return changeset, targetSetWrapper.GetMultaiTargetSet(), nil
 }
 
 func NewMultaiTargetSetWrapper() *MultaiTargetSetWrapper {
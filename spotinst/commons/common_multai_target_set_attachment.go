@@ -0,0 +1,106 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+)
+
+const (
+	MultaiTargetSetAttachmentResourceName ResourceName = "spotinst_multai_target_set_attachment"
+)
+
+var MultaiTargetSetAttachmentResource *MultaiTargetSetAttachmentTerraformResource
+
+type MultaiTargetSetAttachmentTerraformResource struct {
+	GenericResource
+}
+
+// MultaiTargetSetAttachmentSpec binds a target set to an Elastigroup or to a
+// managed instance. It is not an API object, the attachment is a load balancer
+// of type MULTAI_TARGET_SET in the integration of the group or the instance.
+type MultaiTargetSetAttachmentSpec struct {
+	TargetSetID       *string `json:"targetSetId,omitempty"`
+	BalancerID        *string `json:"balancerId,omitempty"`
+	ElastigroupID     *string `json:"elastigroupId,omitempty"`
+	ManagedInstanceID *string `json:"managedInstanceId,omitempty"`
+
+	// Targets are the targets currently registered in the target set.
+	Targets []*multai.Target `json:"targets,omitempty"`
+}
+
+type MultaiTargetSetAttachmentWrapper struct {
+	attachment *MultaiTargetSetAttachmentSpec
+}
+
+func NewMultaiTargetSetAttachmentResource(fieldMap map[FieldName]*GenericField) *MultaiTargetSetAttachmentTerraformResource {
+	return &MultaiTargetSetAttachmentTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: MultaiTargetSetAttachmentResourceName,
+			fields:       NewGenericFields(fieldMap),
+		},
+	}
+}
+
+func (res *MultaiTargetSetAttachmentTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*MultaiTargetSetAttachmentSpec, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	attachmentWrapper := NewMultaiTargetSetAttachmentWrapper()
+
+	for _, field := range res.fields.orderedFields {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(attachmentWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return attachmentWrapper.GetMultaiTargetSetAttachment(), nil
+}
+
+func (res *MultaiTargetSetAttachmentTerraformResource) OnRead(
+	attachment *MultaiTargetSetAttachmentSpec,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	attachmentWrapper := NewMultaiTargetSetAttachmentWrapper()
+	attachmentWrapper.SetMultaiTargetSetAttachment(attachment)
+
+	for _, field := range res.fields.orderedFields {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(attachmentWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewMultaiTargetSetAttachmentWrapper() *MultaiTargetSetAttachmentWrapper {
+	return &MultaiTargetSetAttachmentWrapper{
+		attachment: &MultaiTargetSetAttachmentSpec{},
+	}
+}
+
+func (attachmentWrapper *MultaiTargetSetAttachmentWrapper) GetMultaiTargetSetAttachment() *MultaiTargetSetAttachmentSpec {
+	return attachmentWrapper.attachment
+}
+
+func (attachmentWrapper *MultaiTargetSetAttachmentWrapper) SetMultaiTargetSetAttachment(attachment *MultaiTargetSetAttachmentSpec) {
+	attachmentWrapper.attachment = attachment
+}
@@ -0,0 +1,139 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	MultaiTrafficShiftResourceName ResourceName = "spotinst_multai_traffic_shift"
+)
+
+var MultaiTrafficShiftResource *MultaiTrafficShiftTerraformResource
+
+type MultaiTrafficShiftTerraformResource struct {
+	GenericResource
+}
+
+// MultaiTrafficShiftSpec describes a gradual shift of traffic from one target set
+// to another. It is not an API object, the shift is carried out by updating
+// the weights of the two target sets.
+type MultaiTrafficShiftSpec struct {
+	FromTargetSetID *string `json:"fromTargetSetId,omitempty"`
+	ToTargetSetID   *string `json:"toTargetSetId,omitempty"`
+
+	// Steps are the percentages of the traffic sent to the target set shifted
+	// to, in the order they are applied.
+	Steps []int `json:"steps,omitempty"`
+
+	// StepInterval and HealthCheckInterval are in seconds.
+	StepInterval           *int  `json:"stepInterval,omitempty"`
+	HealthCheckInterval    *int  `json:"healthCheckInterval,omitempty"`
+	MaxUnhealthyPercentage *int  `json:"maxUnhealthyPercentage,omitempty"`
+	Rollback               *bool `json:"rollback,omitempty"`
+
+	// FromWeight and ToWeight are the current weights of the target sets.
+	FromWeight *int `json:"fromWeight,omitempty"`
+	ToWeight   *int `json:"toWeight,omitempty"`
+}
+
+type MultaiTrafficShiftWrapper struct {
+	trafficShift *MultaiTrafficShiftSpec
+}
+
+func NewMultaiTrafficShiftResource(fieldMap map[FieldName]*GenericField) *MultaiTrafficShiftTerraformResource {
+	return &MultaiTrafficShiftTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: MultaiTrafficShiftResourceName,
+			fields:       NewGenericFields(fieldMap),
+		},
+	}
+}
+
+func (res *MultaiTrafficShiftTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*MultaiTrafficShiftSpec, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	trafficShiftWrapper := NewMultaiTrafficShiftWrapper()
+
+	for _, field := range res.fields.orderedFields {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(trafficShiftWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return trafficShiftWrapper.GetMultaiTrafficShift(), nil
+}
+
+func (res *MultaiTrafficShiftTerraformResource) OnRead(
+	trafficShift *MultaiTrafficShiftSpec,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	trafficShiftWrapper := NewMultaiTrafficShiftWrapper()
+	trafficShiftWrapper.SetMultaiTrafficShift(trafficShift)
+
+	for _, field := range res.fields.orderedFields {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(trafficShiftWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OnUpdate returns the changeset of the update. Since a shift always runs all
+// of its steps, the returned traffic shift is complete and not limited to the
+// changed fields.
+func (res *MultaiTrafficShiftTerraformResource) OnUpdate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*Changeset, *MultaiTrafficShiftSpec, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	trafficShiftWrapper := NewMultaiTrafficShiftWrapper()
+	changeset, err := res.UpdateFields(trafficShiftWrapper, trafficShiftWrapper.GetMultaiTrafficShift(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	trafficShift, err := res.OnCreate(resourceData, meta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changeset, trafficShift, nil
+}
+
+func NewMultaiTrafficShiftWrapper() *MultaiTrafficShiftWrapper {
+	return &MultaiTrafficShiftWrapper{
+		trafficShift: &MultaiTrafficShiftSpec{},
+	}
+}
+
+func (trafficShiftWrapper *MultaiTrafficShiftWrapper) GetMultaiTrafficShift() *MultaiTrafficShiftSpec {
+	return trafficShiftWrapper.trafficShift
+}
+
+func (trafficShiftWrapper *MultaiTrafficShiftWrapper) SetMultaiTrafficShift(trafficShift *MultaiTrafficShiftSpec) {
+	trafficShiftWrapper.trafficShift = trafficShift
+}
@@ -47,7 +47,7 @@ func (res *OceanAKSTerraformResource) OnCreate(
 		clusterWrapper.SetCluster(importedCluster)
 	}
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -71,7 +71,7 @@ func (res *OceanAKSTerraformResource) OnRead(
 	clusterWrapper := NewAKSClusterWrapper()
 	clusterWrapper.SetCluster(cluster)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -86,28 +86,19 @@ func (res *OceanAKSTerraformResource) OnRead(
 
 func (res *OceanAKSTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *azure.Cluster, error) {
+	meta interface{}) (*Changeset, *azure.Cluster, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	clusterWrapper := NewAKSClusterWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(clusterWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(clusterWrapper, clusterWrapper.GetCluster(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, clusterWrapper.GetCluster(), nil
+	return changeset, clusterWrapper.GetCluster(), nil
 }
 
 func NewAKSClusterWrapper() *AKSClusterWrapper {
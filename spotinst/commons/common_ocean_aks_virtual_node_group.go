@@ -39,7 +39,7 @@ func (res *OceanAKSVirtualNodeGroupTerraformResource) OnCreate(
 
 	launchSpecWrapper := NewVirtualNodeGroupAKSWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -63,7 +63,7 @@ func (res *OceanAKSVirtualNodeGroupTerraformResource) OnRead(
 	launchSpecWrapper := NewVirtualNodeGroupAKSWrapper()
 	launchSpecWrapper.SetVirtualNodeGroup(launchSpec)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -78,28 +78,19 @@ func (res *OceanAKSVirtualNodeGroupTerraformResource) OnRead(
 
 func (res *OceanAKSVirtualNodeGroupTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *azure.VirtualNodeGroup, error) {
+	meta interface{}) (*Changeset, *azure.VirtualNodeGroup, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	launchSpecWrapper := NewVirtualNodeGroupAKSWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(launchSpecWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(launchSpecWrapper, launchSpecWrapper.GetVirtualNodeGroup(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, launchSpecWrapper.GetVirtualNodeGroup(), nil
+	return changeset, launchSpecWrapper.GetVirtualNodeGroup(), nil
 }
 
 func NewVirtualNodeGroupAKSWrapper() *VirtualNodeGroupAKSWrapper {
@@ -41,7 +41,7 @@ func (res *OceanAWSTerraformResource) OnCreate(
 
 	clusterWrapper := NewClusterWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -65,7 +65,7 @@ func (res *OceanAWSTerraformResource) OnRead(
 	clusterWrapper := NewClusterWrapper()
 	clusterWrapper.SetCluster(cluster)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -80,28 +80,19 @@ func (res *OceanAWSTerraformResource) OnRead(
 
 func (res *OceanAWSTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *aws.Cluster, error) {
+	meta interface{}) (*Changeset, *aws.Cluster, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	clusterWrapper := NewClusterWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(clusterWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(clusterWrapper, clusterWrapper.GetCluster(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, clusterWrapper.GetCluster(), nil
+	return changeset, clusterWrapper.GetCluster(), nil
 }
 
 func NewClusterWrapper() *AWSClusterWrapper {
@@ -41,7 +41,7 @@ func (res *OceanAWSLaunchSpecTerraformResource) OnCreate(
 
 	launchSpecWrapper := NewLaunchSpecWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -65,7 +65,7 @@ func (res *OceanAWSLaunchSpecTerraformResource) OnRead(
 	launchSpecWrapper := NewLaunchSpecWrapper()
 	launchSpecWrapper.SetLaunchSpec(launchSpec)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -80,28 +80,19 @@ func (res *OceanAWSLaunchSpecTerraformResource) OnRead(
 
 func (res *OceanAWSLaunchSpecTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *aws.LaunchSpec, error) {
+	meta interface{}) (*Changeset, *aws.LaunchSpec, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	launchSpecWrapper := NewLaunchSpecWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(launchSpecWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(launchSpecWrapper, launchSpecWrapper.GetLaunchSpec(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, launchSpecWrapper.GetLaunchSpec(), nil
+	return changeset, launchSpecWrapper.GetLaunchSpec(), nil
 }
 
 func NewLaunchSpecWrapper() *LaunchSpecWrapper {
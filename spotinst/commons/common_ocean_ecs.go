@@ -41,7 +41,7 @@ func (res *OceanECSTerraformResource) OnCreate(
 
 	clusterWrapper := NewECSClusterWrapper()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -65,7 +65,7 @@ func (res *OceanECSTerraformResource) OnRead(
 	clusterWrapper := NewECSClusterWrapper()
 	clusterWrapper.SetECSCluster(cluster)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -80,28 +80,19 @@ func (res *OceanECSTerraformResource) OnRead(
 
 func (res *OceanECSTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *aws.ECSCluster, error) {
+	meta interface{}) (*Changeset, *aws.ECSCluster, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	clusterWrapper := NewECSClusterWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(clusterWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(clusterWrapper, clusterWrapper.GetECSCluster(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, clusterWrapper.GetECSCluster(), nil
+	return changeset, clusterWrapper.GetECSCluster(), nil
 }
 
 func NewECSClusterWrapper() *ECSClusterWrapper {
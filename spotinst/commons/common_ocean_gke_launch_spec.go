@@ -47,7 +47,7 @@ func (res *OceanGKELaunchSpecTerraformResource) OnCreate(
 		launchSpecWrapper.SetLaunchSpec(importedLaunchSpec)
 	}
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -71,7 +71,7 @@ func (res *OceanGKELaunchSpecTerraformResource) OnRead(
 	launchSpecWrapper := NewGKELaunchSpecWrapper()
 	launchSpecWrapper.SetLaunchSpec(launchSpec)
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -86,28 +86,19 @@ func (res *OceanGKELaunchSpecTerraformResource) OnRead(
 
 func (res *OceanGKELaunchSpecTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *gcp.LaunchSpec, error) {
+	meta interface{}) (*Changeset, *gcp.LaunchSpec, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	launchSpecWrapper := NewGKELaunchSpecWrapper()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(launchSpecWrapper, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(launchSpecWrapper, launchSpecWrapper.GetLaunchSpec(), resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, launchSpecWrapper.GetLaunchSpec(), nil
+	return changeset, launchSpecWrapper.GetLaunchSpec(), nil
 }
 
 func NewGKELaunchSpecWrapper() *LaunchSpecGKEWrapper {
@@ -2,8 +2,11 @@ package commons
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"math/rand"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -37,11 +40,23 @@ type GenericField struct {
 	onCreate         onFieldCreate
 	onUpdate         onFieldUpdate
 	hasChangeCustom  hasFieldChange
+
+	// sensitiveKeys holds the names of the attributes of the field that are
+	// marked Sensitive, whose values are redacted from logs.
+	sensitiveKeys []string
+
+	// order and dependencies define when the handlers of the field run
+	// relative to the other fields of the resource, see NewGenericFields.
+	order        int
+	dependencies []FieldName
 }
 
 type GenericFields struct {
 	fieldsMap map[FieldName]*GenericField
 	schemaMap map[string]*schema.Schema
+
+	// orderedFields holds the fields in the order their handlers run.
+	orderedFields []*GenericField
 }
 
 func NewGenericField(
@@ -54,6 +69,9 @@ func NewGenericField(
 	hasChangeCustom hasFieldChange,
 ) *GenericField {
 
+	sensitiveKeys := sensitiveSchemaKeys(string(fieldName), schema)
+	RegisterSensitiveKeys(sensitiveKeys...)
+
 	return &GenericField{
 		resourceAffinity: resourceAffinity,
 		fieldNameStr:     string(fieldName),
@@ -63,9 +81,14 @@ func NewGenericField(
 		onCreate:         onCreate,
 		onUpdate:         onUpdate,
 		hasChangeCustom:  hasChangeCustom,
+		sensitiveKeys:    sensitiveKeys,
 	}
 }
 
+// NewGenericFields returns the fields of a resource. Field handlers run in a
+// deterministic order: a field runs after the fields it depends on, and
+// otherwise by ascending order and then by name. A dependency cycle is a
+// programming error and panics.
 func NewGenericFields(fieldsMap map[FieldName]*GenericField) *GenericFields {
 	var schemaMap = make(map[string]*schema.Schema)
 
@@ -73,16 +96,105 @@ func NewGenericFields(fieldsMap map[FieldName]*GenericField) *GenericFields {
 		schemaMap[field.fieldNameStr] = field.schema
 	}
 
+	orderedFields, err := orderFields(fieldsMap)
+	if err != nil {
+		panic(err)
+	}
+
 	return &GenericFields{
-		fieldsMap: fieldsMap,
-		schemaMap: schemaMap,
+		fieldsMap:     fieldsMap,
+		schemaMap:     schemaMap,
+		orderedFields: orderedFields,
 	}
 }
 
+// orderFields sorts the fields topologically by their dependencies, breaking
+// ties by order and then by name. Dependencies on fields that are not part of
+// the resource are ignored.
+func orderFields(fieldsMap map[FieldName]*GenericField) ([]*GenericField, error) {
+	pending := make(map[FieldName]int, len(fieldsMap))
+	dependents := make(map[FieldName][]FieldName, len(fieldsMap))
+	for name, field := range fieldsMap {
+		pending[name] = 0
+		for _, dep := range field.dependencies {
+			if _, ok := fieldsMap[dep]; ok && dep != name {
+				pending[name]++
+				dependents[dep] = append(dependents[dep], name)
+			}
+		}
+	}
+
+	less := func(a, b *GenericField) bool {
+		if a.order != b.order {
+			return a.order < b.order
+		}
+		return a.fieldNameStr < b.fieldNameStr
+	}
+
+	var ready []*GenericField
+	for name, count := range pending {
+		if count == 0 {
+			ready = append(ready, fieldsMap[name])
+		}
+	}
+
+	ordered := make([]*GenericField, 0, len(fieldsMap))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool { return less(ready[i], ready[j]) })
+		field := ready[0]
+		ready = ready[1:]
+		ordered = append(ordered, field)
+
+		for _, name := range dependents[field.fieldName] {
+			if pending[name]--; pending[name] == 0 {
+				ready = append(ready, fieldsMap[name])
+			}
+		}
+	}
+
+	if len(ordered) != len(fieldsMap) {
+		var cycle []string
+		for name, count := range pending {
+			if count > 0 {
+				cycle = append(cycle, string(name))
+			}
+		}
+		sort.Strings(cycle)
+		return nil, fmt.Errorf("dependency cycle between fields: %s", strings.Join(cycle, ", "))
+	}
+	return ordered, nil
+}
+
 func (field *GenericField) GetSchema() *schema.Schema {
 	return field.schema
 }
 
+// SetOrder sets the order of the field among the fields of the resource it
+// has no dependency relation with. Lower orders run first, the default is 0.
+func (field *GenericField) SetOrder(order int) *GenericField {
+	field.order = order
+	return field
+}
+
+// SetDependencies declares the fields whose handlers must run before the
+// handlers of this field, e.g. fields that build the same shared API object.
+func (field *GenericField) SetDependencies(fieldNames ...FieldName) *GenericField {
+	field.dependencies = append(field.dependencies, fieldNames...)
+	return field
+}
+
+// IsSensitive reports whether the field, or any of its nested attributes, is
+// marked Sensitive.
+func (field *GenericField) IsSensitive() bool {
+	return len(field.sensitiveKeys) > 0
+}
+
+// SensitiveKeys returns the names of the attributes of the field that are
+// marked Sensitive.
+func (field *GenericField) SensitiveKeys() []string {
+	return field.sensitiveKeys
+}
+
 func (field *GenericField) hasFieldChange(resourceData *schema.ResourceData, meta interface{}) bool {
 	if field.hasChangeCustom != nil {
 		return field.hasChangeCustom(resourceData, meta)
@@ -97,6 +209,15 @@ func (res *GenericResource) GetField(fieldName FieldName) *GenericField {
 	return nil
 }
 
+// GetOrderedFields returns the fields of the resource in the order their
+// handlers run.
+func (res *GenericResource) GetOrderedFields() []*GenericField {
+	if res.fields == nil {
+		return nil
+	}
+	return res.fields.orderedFields
+}
+
 func (res *GenericResource) GetSchemaMap() map[string]*schema.Schema {
 	if res.fields == nil || res.fields.schemaMap == nil || len(res.fields.schemaMap) == 0 {
 		log.Printf("[ERROR] Resource schema is nil or empty")
@@ -109,10 +230,12 @@ func (res *GenericResource) GetName() string {
 	return string(res.resourceName)
 }
 
+// ToJson returns the indented JSON representation of the object for logging.
+// Values of attributes marked Sensitive are redacted.
 func ToJson(object interface{}) (string, error) {
 	if bytes, err := json.MarshalIndent(object, "", "  "); err != nil {
 		return "", err
 	} else {
-		return string(bytes), nil
+		return Redact(string(bytes)), nil
 	}
 }
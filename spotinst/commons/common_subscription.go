@@ -38,7 +38,7 @@ func (res *SubscriptionTerraformResource) OnRead(
 		return fmt.Errorf("resource fields are nil or empty, cannot read")
 	}
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onRead == nil {
 			continue
 		}
@@ -60,7 +60,7 @@ func (res *SubscriptionTerraformResource) OnCreate(
 
 	sub := NewSubscription()
 
-	for _, field := range res.fields.fieldsMap {
+	for _, field := range res.fields.orderedFields {
 		if field.onCreate == nil {
 			continue
 		}
@@ -74,28 +74,19 @@ func (res *SubscriptionTerraformResource) OnCreate(
 
 func (res *SubscriptionTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *subscription.Subscription, error) {
+	meta interface{}) (*Changeset, *subscription.Subscription, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return nil, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	sub := NewSubscription()
-	hasChanged := false
-	for _, field := range res.fields.fieldsMap {
-		if field.onUpdate == nil {
-			continue
-		}
-		if field.hasFieldChange(resourceData, meta) {
-			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
-			if err := field.onUpdate(sub, resourceData, meta); err != nil {
-				return false, nil, err
-			}
-			hasChanged = true
-		}
+	changeset, err := res.UpdateFields(sub, sub, resourceData, meta)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return hasChanged, sub, nil
+	return changeset, sub, nil
 }
 
 func NewSubscription() *subscription.Subscription {
@@ -78,10 +78,14 @@ const (
 	ElastigroupAWSLaunchConfiguration ResourceAffinity = "Elastigroup_AWS_Launch_Configuration"
 	ElastigroupAWSNetworkInterface    ResourceAffinity = "Elastigroup_AWS_Network_Interface"
 	ElastigroupAWSScheduledTask       ResourceAffinity = "Elastigroup_AWS_Scheduled_Task"
+	ElastigroupAWSStandaloneTask      ResourceAffinity = "Elastigroup_AWS_Standalone_Scheduled_Task"
 	ElastigroupAWSBlockDevices        ResourceAffinity = "Elastigroup_AWS_Block_Device"
 	ElastigroupAWSScalingPolicies     ResourceAffinity = "Elastigroup_AWS_Scaling_Policies"
+	ElastigroupAWSScalingPolicy       ResourceAffinity = "Elastigroup_AWS_Scaling_Policy"
 	ElastigroupAWSIntegrations        ResourceAffinity = "Elastigroup_AWS_Integrations"
 
+	ElastigroupAWSStatefulInstanceState ResourceAffinity = "Elastigroup_AWS_Stateful_Instance_State"
+
 	ManagedInstanceAWS                    ResourceAffinity = "Managed_Instance_AWS"
 	ManagedInstanceAWSStrategy            ResourceAffinity = "Managed_Instance_AWS_Strategy"
 	ManagedInstanceAWSPersistence         ResourceAffinity = "Managed_Instance_AWS_Persistence"
@@ -93,6 +97,8 @@ const (
 	ManagedInstanceAWSScheduling          ResourceAffinity = "Managed_Instance_AWS_Scheduling"
 	ManagedInstanceAWSComputeInstanceType ResourceAffinity = "Managed_Instance_AWS_Compute_Instance_Type"
 
+	ManagedInstanceAWSState ResourceAffinity = "Managed_Instance_AWS_State"
+
 	ElastigroupGCP                    ResourceAffinity = "Elastigroup_GCP"
 	ElastigroupGCPDisk                ResourceAffinity = "Elastigroup_GCP_Disk"
 	ElastigroupGCPGPU                 ResourceAffinity = "Elastigroup_GPC_GPU"
@@ -130,12 +136,17 @@ const (
 	MRScalerAWSScheduledTask       ResourceAffinity = "MRScaler_AWS_Scheduled_Task"
 	MRScalerAWSTerminationPolicies ResourceAffinity = "MRScaler_AWS_Termination_Policies"
 
-	MultaiBalancer    ResourceAffinity = "Multai_Balancer"
-	MultaiDeployment  ResourceAffinity = "Multai_Deployment"
-	MultaiListener    ResourceAffinity = "Multai_Listener"
-	MultaiRoutingRule ResourceAffinity = "Multai_Routing_Rule"
-	MultaiTarget      ResourceAffinity = "Multai_Target"
-	MultaiTargetSet   ResourceAffinity = "Multai_Target_Set"
+	MultaiBalancer     ResourceAffinity = "Multai_Balancer"
+	MultaiCertificate  ResourceAffinity = "Multai_Certificate"
+	MultaiDeployment   ResourceAffinity = "Multai_Deployment"
+	MultaiListener     ResourceAffinity = "Multai_Listener"
+	MultaiMiddleware   ResourceAffinity = "Multai_Middleware"
+	MultaiRoutingRule  ResourceAffinity = "Multai_Routing_Rule"
+	MultaiTarget       ResourceAffinity = "Multai_Target"
+	MultaiTargetSet    ResourceAffinity = "Multai_Target_Set"
+	MultaiTrafficShift ResourceAffinity = "Multai_Traffic_Shift"
+
+	MultaiTargetSetAttachment ResourceAffinity = "Multai_Target_Set_Attachment"
 
 	HealthCheck ResourceAffinity = "Health_Check"
 
@@ -150,4 +161,12 @@ const (
 	ResourceOnUpdate LogFormat = "onUpdate() -> %s -> started for %s..."
 	ResourceOnRead   LogFormat = "onRead() -> %s -> started for %s..."
 	ResourceOnCreate LogFormat = "onCreate() -> %s -> started..."
+
+	DataSourceOnRead LogFormat = "onRead() -> %s -> data source lookup started..."
+)
+
+// Instance states shared by the resources that pause and resume instances.
+const (
+	InstanceStateRunning = "running"
+	InstanceStatePaused  = "paused"
 )
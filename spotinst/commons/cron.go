@@ -0,0 +1,480 @@
+package commons
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// CronNextExecutionsCount is the number of upcoming runs reported in the
+// computed `next_executions` attribute of scheduled tasks.
+const CronNextExecutionsCount = 5
+
+// cronSearchLimit bounds the search for the next run of schedules that rarely
+// or never match, e.g. `0 0 30 2 *`.
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+
+	// noSpecificValue allows `?` in place of `*`.
+	noSpecificValue bool
+}
+
+var (
+	cronMonthNames = []string{
+		"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	cronDayNames = []string{
+		"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+)
+
+var (
+	cronSecondField     = cronField{name: "second", min: 0, max: 59}
+	cronMinuteField     = cronField{name: "minute", min: 0, max: 59}
+	cronHourField       = cronField{name: "hour", min: 0, max: 23}
+	cronDayOfMonthField = cronField{name: "day of month", min: 1, max: 31, noSpecificValue: true}
+	cronMonthField      = cronField{name: "month", min: 1, max: 12, names: cronMonthNames}
+	cronYearField       = cronField{name: "year", min: 1970, max: 2099}
+
+	// Unix cron numbers the days of the week from 0 (or 7) for Sunday, Quartz
+	// from 1 for Sunday.
+	cronUnixDayOfWeekField   = cronField{name: "day of week", min: 0, max: 7, names: cronDayNames, noSpecificValue: true}
+	cronQuartzDayOfWeekField = cronField{name: "day of week", min: 1, max: 7, names: cronDayNames, noSpecificValue: true}
+)
+
+// cronSet is a set of the values of a cron field, stored as bits relative to
+// the lowest value of the field.
+type cronSet struct {
+	offset int
+	bits   [3]uint64
+}
+
+func (s *cronSet) add(n int) {
+	i := n - s.offset
+	s.bits[i/64] |= 1 << uint(i%64)
+}
+
+func (s *cronSet) has(n int) bool {
+	i := n - s.offset
+	if i < 0 || i >= 64*len(s.bits) {
+		return false
+	}
+	return s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// cronDayRule matches the days selected by the Quartz `L`, `W` and `#`
+// characters, which depend on the month.
+type cronDayRule func(t time.Time) bool
+
+// CronSchedule is a parsed cron expression. All schedules run in UTC.
+type CronSchedule struct {
+	second, minute, hour, dom, month, dow, year cronSet
+
+	// domRules and dowRules hold the Quartz `L`, `W` and `#` items of the day
+	// fields.
+	domRules, dowRules []cronDayRule
+
+	// domAny and dowAny record an unrestricted field, which changes how the
+	// day of month and day of week fields combine.
+	domAny, dowAny bool
+}
+
+// ParseCronExpression parses a cron expression in either of the formats
+// Spotinst runs scheduled tasks with: Unix cron
+// (`minute hour day-of-month month day-of-week`), or Quartz cron
+// (`second minute hour day-of-month month day-of-week [year]`), which also
+// supports the `L`, `W` and `#` characters in the day fields.
+func ParseCronExpression(expr string) (*CronSchedule, error) {
+	parts := strings.Fields(expr)
+	switch len(parts) {
+	case 5:
+		return parseUnixCron(parts)
+	case 6, 7:
+		return parseQuartzCron(parts)
+	default:
+		return nil, fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week) "+
+			"or 6-7 fields (second minute hour day-of-month month day-of-week [year]), got %d", len(parts))
+	}
+}
+
+func parseUnixCron(parts []string) (*CronSchedule, error) {
+	s := newCronSchedule()
+	s.second.add(0)
+	for y := cronYearField.min; y <= cronYearField.max; y++ {
+		s.year.add(y)
+	}
+
+	if err := parseCronList(parts[0], cronMinuteField, &s.minute); err != nil {
+		return nil, err
+	}
+	if err := parseCronList(parts[1], cronHourField, &s.hour); err != nil {
+		return nil, err
+	}
+	if err := parseCronList(parts[2], cronDayOfMonthField, &s.dom); err != nil {
+		return nil, err
+	}
+	if err := parseCronList(parts[3], cronMonthField, &s.month); err != nil {
+		return nil, err
+	}
+
+	dow := cronSet{offset: cronUnixDayOfWeekField.min}
+	if err := parseCronList(parts[4], cronUnixDayOfWeekField, &dow); err != nil {
+		return nil, err
+	}
+	for n := 0; n <= 7; n++ {
+		if dow.has(n) {
+			// Both 0 and 7 stand for Sunday.
+			s.dow.add(n % 7)
+		}
+	}
+
+	s.domAny = isCronAny(parts[2], cronDayOfMonthField)
+	s.dowAny = isCronAny(parts[4], cronUnixDayOfWeekField)
+	return s, nil
+}
+
+func parseQuartzCron(parts []string) (*CronSchedule, error) {
+	s := newCronSchedule()
+
+	if err := parseCronList(parts[0], cronSecondField, &s.second); err != nil {
+		return nil, err
+	}
+	if err := parseCronList(parts[1], cronMinuteField, &s.minute); err != nil {
+		return nil, err
+	}
+	if err := parseCronList(parts[2], cronHourField, &s.hour); err != nil {
+		return nil, err
+	}
+	if err := parseQuartzDayOfMonth(parts[3], s); err != nil {
+		return nil, err
+	}
+	if err := parseCronList(parts[4], cronMonthField, &s.month); err != nil {
+		return nil, err
+	}
+	if err := parseQuartzDayOfWeek(parts[5], s); err != nil {
+		return nil, err
+	}
+
+	year := "*"
+	if len(parts) == 7 {
+		year = parts[6]
+	}
+	if err := parseCronList(year, cronYearField, &s.year); err != nil {
+		return nil, err
+	}
+
+	s.domAny = isCronAny(parts[3], cronDayOfMonthField)
+	s.dowAny = isCronAny(parts[5], cronQuartzDayOfWeekField)
+	return s, nil
+}
+
+func newCronSchedule() *CronSchedule {
+	return &CronSchedule{
+		second: cronSet{offset: cronSecondField.min},
+		minute: cronSet{offset: cronMinuteField.min},
+		hour:   cronSet{offset: cronHourField.min},
+		dom:    cronSet{offset: cronDayOfMonthField.min},
+		month:  cronSet{offset: cronMonthField.min},
+		dow:    cronSet{offset: 0},
+		year:   cronSet{offset: cronYearField.min},
+	}
+}
+
+// parseQuartzDayOfMonth parses the day of month field, with `L` (the last day
+// of the month), `L-n` (n days before it), `nW` (the weekday nearest to day n)
+// and `LW` (the last weekday of the month).
+func parseQuartzDayOfMonth(part string, s *CronSchedule) error {
+	field := cronDayOfMonthField
+	for _, item := range strings.Split(part, ",") {
+		upper := strings.ToUpper(item)
+		switch {
+		case upper == "L":
+			s.domRules = append(s.domRules, func(t time.Time) bool {
+				return t.Day() == cronLastDay(t)
+			})
+		case upper == "LW":
+			s.domRules = append(s.domRules, func(t time.Time) bool {
+				return t.Day() == cronNearestWeekday(t, cronLastDay(t))
+			})
+		case strings.HasPrefix(upper, "L-"):
+			n, err := strconv.Atoi(upper[2:])
+			if err != nil || n < 0 || n > 30 {
+				return fmt.Errorf("%s field: invalid offset in %q", field.name, item)
+			}
+			s.domRules = append(s.domRules, func(t time.Time) bool {
+				return t.Day() == cronLastDay(t)-n
+			})
+		case strings.HasSuffix(upper, "W"):
+			n, err := parseCronValue(upper[:len(upper)-1], field)
+			if err != nil {
+				return fmt.Errorf("%s field: %v", field.name, err)
+			}
+			s.domRules = append(s.domRules, func(t time.Time) bool {
+				return n <= cronLastDay(t) && t.Day() == cronNearestWeekday(t, n)
+			})
+		default:
+			if err := parseCronList(item, field, &s.dom); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parseQuartzDayOfWeek parses the day of week field, numbered from 1 for
+// Sunday, with `L` (Saturday), `nL` (the last day n of the month) and `n#k`
+// (the k-th day n of the month).
+func parseQuartzDayOfWeek(part string, s *CronSchedule) error {
+	field := cronQuartzDayOfWeekField
+	dow := cronSet{offset: field.min}
+	for _, item := range strings.Split(part, ",") {
+		upper := strings.ToUpper(item)
+		switch {
+		case upper == "L":
+			dow.add(7)
+		case strings.HasSuffix(upper, "L"):
+			n, err := parseCronValue(upper[:len(upper)-1], field)
+			if err != nil {
+				return fmt.Errorf("%s field: %v", field.name, err)
+			}
+			weekday := time.Weekday(n - 1)
+			s.dowRules = append(s.dowRules, func(t time.Time) bool {
+				return t.Weekday() == weekday && t.Day()+7 > cronLastDay(t)
+			})
+		case strings.Contains(upper, "#"):
+			i := strings.Index(upper, "#")
+			n, err := parseCronValue(upper[:i], field)
+			if err != nil {
+				return fmt.Errorf("%s field: %v", field.name, err)
+			}
+			k, err := strconv.Atoi(upper[i+1:])
+			if err != nil || k < 1 || k > 5 {
+				return fmt.Errorf("%s field: invalid occurrence in %q", field.name, item)
+			}
+			weekday := time.Weekday(n - 1)
+			s.dowRules = append(s.dowRules, func(t time.Time) bool {
+				return t.Weekday() == weekday && (t.Day()-1)/7+1 == k
+			})
+		default:
+			if err := parseCronList(item, field, &dow); err != nil {
+				return err
+			}
+		}
+	}
+	for n := 1; n <= 7; n++ {
+		if dow.has(n) {
+			s.dow.add(n - 1)
+		}
+	}
+	return nil
+}
+
+// Next returns the first time after t, in UTC, the schedule matches. The zero
+// time is returned when there is no such time within the search limit.
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Second).Add(time.Second)
+	limit := t.Add(cronSearchLimit)
+
+	for t.Before(limit) {
+		if !s.year.has(t.Year()) {
+			t = time.Date(t.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.month.has(int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.hour.has(t.Hour()) {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if !s.minute.has(t.Minute()) {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+		if !s.second.has(t.Second()) {
+			t = t.Add(time.Second)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// matchDay follows Unix cron semantics: when both day fields are restricted,
+// a day matching either of them matches.
+func (s *CronSchedule) matchDay(t time.Time) bool {
+	domMatch := s.dom.has(t.Day()) || matchCronDayRules(s.domRules, t)
+	dowMatch := s.dow.has(int(t.Weekday())) || matchCronDayRules(s.dowRules, t)
+	if s.domAny || s.dowAny {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func matchCronDayRules(rules []cronDayRule, t time.Time) bool {
+	for _, rule := range rules {
+		if rule(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// cronLastDay returns the last day of the month of t.
+func cronLastDay(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// cronNearestWeekday returns the weekday nearest to the given day of the month
+// of t, without leaving the month.
+func cronNearestWeekday(t time.Time, day int) int {
+	d := time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, time.UTC)
+	switch d.Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == cronLastDay(t) {
+			return day - 2
+		}
+		return day + 1
+	default:
+		return day
+	}
+}
+
+// ValidateCronExpression is a schema.SchemaValidateFunc that rejects invalid
+// cron expressions at plan time.
+func ValidateCronExpression(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+	if value == "" {
+		return
+	}
+	if _, err := ParseCronExpression(value); err != nil {
+		es = append(es, fmt.Errorf("%s: invalid cron expression %q: %v", k, value, err))
+	}
+	return
+}
+
+// CronNextExecutions returns the next CronNextExecutionsCount runs of the cron
+// expression as RFC 3339 UTC timestamps. Empty or invalid expressions have no
+// runs.
+func CronNextExecutions(expr string) []interface{} {
+	result := make([]interface{}, 0, CronNextExecutionsCount)
+	if expr == "" {
+		return result
+	}
+	s, err := ParseCronExpression(expr)
+	if err != nil {
+		return result
+	}
+
+	t := time.Now()
+	for len(result) < CronNextExecutionsCount {
+		if t = s.Next(t); t.IsZero() {
+			break
+		}
+		result = append(result, t.Format(time.RFC3339))
+	}
+	return result
+}
+
+// NextExecutionsSchema returns the schema of the computed `next_executions`
+// attribute of scheduled tasks.
+func NextExecutionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func isCronAny(part string, field cronField) bool {
+	return part == "*" || (part == "?" && field.noSpecificValue)
+}
+
+func parseCronList(part string, field cronField, set *cronSet) error {
+	for _, item := range strings.Split(part, ",") {
+		if err := parseCronItem(item, field, set); err != nil {
+			return fmt.Errorf("%s field: %v", field.name, err)
+		}
+	}
+	return nil
+}
+
+func parseCronItem(item string, field cronField, set *cronSet) error {
+	if item == "" {
+		return fmt.Errorf("empty list item")
+	}
+
+	rangePart, step := item, 0
+	if i := strings.Index(item, "/"); i >= 0 {
+		rangePart = item[:i]
+		n, err := strconv.Atoi(item[i+1:])
+		if err != nil || n < 1 {
+			return fmt.Errorf("invalid step in %q", item)
+		}
+		step = n
+	}
+
+	low, high := field.min, field.max
+	if !isCronAny(rangePart, field) {
+		bounds := strings.SplitN(rangePart, "-", 2)
+		var err error
+		if low, err = parseCronValue(bounds[0], field); err != nil {
+			return err
+		}
+		switch {
+		case len(bounds) == 2:
+			if high, err = parseCronValue(bounds[1], field); err != nil {
+				return err
+			}
+			if high < low {
+				return fmt.Errorf("invalid range %q", rangePart)
+			}
+		case step == 0:
+			// A single value.
+			high = low
+		}
+	}
+
+	if step == 0 {
+		step = 1
+	}
+	for n := low; n <= high; n += step {
+		set.add(n)
+	}
+	return nil
+}
+
+func parseCronValue(value string, field cronField) (int, error) {
+	for i, name := range field.names {
+		if strings.EqualFold(value, name) {
+			return i + field.min, nil
+		}
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	if n < field.min || n > field.max {
+		return 0, fmt.Errorf("value %d out of range [%d-%d]", n, field.min, field.max)
+	}
+	return n, nil
+}
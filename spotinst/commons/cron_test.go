@@ -0,0 +1,215 @@
+package commons
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	// Friday.
+	from := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name string
+		expr string
+		from time.Time
+		want []string
+	}{
+		{
+			name: "step",
+			expr: "*/15 * * * *",
+			want: []string{"2021-01-01T00:15:00Z", "2021-01-01T00:30:00Z", "2021-01-01T00:45:00Z", "2021-01-01T01:00:00Z"},
+		},
+		{
+			name: "range",
+			expr: "0 9-11 * * *",
+			want: []string{"2021-01-01T09:00:00Z", "2021-01-01T10:00:00Z", "2021-01-01T11:00:00Z", "2021-01-02T09:00:00Z"},
+		},
+		{
+			name: "range with step",
+			expr: "0 8-18/5 * * *",
+			want: []string{"2021-01-01T08:00:00Z", "2021-01-01T13:00:00Z", "2021-01-01T18:00:00Z", "2021-01-02T08:00:00Z"},
+		},
+		{
+			name: "list",
+			expr: "0,30 12 * * *",
+			want: []string{"2021-01-01T12:00:00Z", "2021-01-01T12:30:00Z", "2021-01-02T12:00:00Z"},
+		},
+		{
+			name: "named months",
+			expr: "0 0 1 MAR,jun *",
+			want: []string{"2021-03-01T00:00:00Z", "2021-06-01T00:00:00Z", "2022-03-01T00:00:00Z"},
+		},
+		{
+			name: "named days",
+			expr: "30 6 * * MON-WED",
+			want: []string{"2021-01-04T06:30:00Z", "2021-01-05T06:30:00Z", "2021-01-06T06:30:00Z", "2021-01-11T06:30:00Z"},
+		},
+		{
+			name: "sunday as 7",
+			expr: "0 0 * * 7",
+			want: []string{"2021-01-03T00:00:00Z", "2021-01-10T00:00:00Z"},
+		},
+		{
+			name: "day of month only",
+			expr: "0 0 13 * *",
+			want: []string{"2021-01-13T00:00:00Z", "2021-02-13T00:00:00Z"},
+		},
+		{
+			name: "day of month or day of week",
+			expr: "0 0 13 * FRI",
+			want: []string{"2021-01-08T00:00:00Z", "2021-01-13T00:00:00Z", "2021-01-15T00:00:00Z", "2021-01-22T00:00:00Z"},
+		},
+		{
+			name: "never",
+			expr: "0 0 30 2 *",
+			want: nil,
+		},
+		{
+			name: "quartz seconds",
+			expr: "15,45 0 0 * * ?",
+			want: []string{"2021-01-01T00:00:15Z", "2021-01-01T00:00:45Z", "2021-01-02T00:00:15Z"},
+		},
+		{
+			name: "quartz year",
+			expr: "30 0 0 1 1 ? 2022",
+			want: []string{"2022-01-01T00:00:30Z"},
+		},
+		{
+			name: "quartz last day of month",
+			expr: "0 0 0 L * ?",
+			want: []string{"2021-01-31T00:00:00Z", "2021-02-28T00:00:00Z", "2021-03-31T00:00:00Z"},
+		},
+		{
+			name: "quartz days before the last day of month",
+			expr: "0 0 0 L-2 * ?",
+			want: []string{"2021-01-29T00:00:00Z", "2021-02-26T00:00:00Z"},
+		},
+		{
+			name: "quartz nearest weekday",
+			expr: "0 0 0 15W * ?",
+			from: time.Date(2021, time.May, 1, 0, 0, 0, 0, time.UTC),
+			// May 15 and Aug 15 are a Saturday and a Sunday.
+			want: []string{"2021-05-14T00:00:00Z", "2021-06-15T00:00:00Z", "2021-07-15T00:00:00Z", "2021-08-16T00:00:00Z"},
+		},
+		{
+			name: "quartz nth day of week",
+			expr: "0 0 12 ? * MON#2",
+			want: []string{"2021-01-11T12:00:00Z", "2021-02-08T12:00:00Z", "2021-03-08T12:00:00Z"},
+		},
+		{
+			name: "quartz last day of week",
+			expr: "0 0 0 ? * 6L",
+			want: []string{"2021-01-29T00:00:00Z", "2021-02-26T00:00:00Z"},
+		},
+		{
+			name: "quartz day of week numbered from sunday",
+			expr: "0 0 0 ? * 1",
+			want: []string{"2021-01-03T00:00:00Z", "2021-01-10T00:00:00Z"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := ParseCronExpression(tc.expr)
+			if err != nil {
+				t.Fatalf("ParseCronExpression(%q): unexpected error: %v", tc.expr, err)
+			}
+
+			next := tc.from
+			if next.IsZero() {
+				next = from
+			}
+			for i, want := range tc.want {
+				next = s.Next(next)
+				if got := next.Format(time.RFC3339); got != want {
+					t.Fatalf("run %d of %q: got %s, want %s", i, tc.expr, got, want)
+				}
+			}
+			if tc.want == nil {
+				if next = s.Next(next); !next.IsZero() {
+					t.Fatalf("%q: got %s, want no run", tc.expr, next.Format(time.RFC3339))
+				}
+			}
+		})
+	}
+}
+
+func TestCronScheduleNextAfterLastRun(t *testing.T) {
+	s, err := ParseCronExpression("30 0 0 1 1 ? 2022")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	last := time.Date(2022, time.January, 1, 0, 0, 30, 0, time.UTC)
+	if next := s.Next(last); !next.IsZero() {
+		t.Fatalf("got %s, want no run", next.Format(time.RFC3339))
+	}
+}
+
+func TestParseCronExpressionInvalid(t *testing.T) {
+	testCases := []string{
+		"",
+		"* * * *",
+		"* * * * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * 32 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"* * * FOO *",
+		"* * * * FUN",
+		"*/0 * * * *",
+		"*/x * * * *",
+		"5-1 * * * *",
+		"1,,2 * * * *",
+		"? * * * *",
+		"* * * ? *",
+		"0 0 0 L-31 * ?",
+		"0 0 0 32W * ?",
+		"0 0 0 ? * MON#6",
+		"0 0 0 ? * 8L",
+		"0 0 0 * * ? 1969",
+	}
+
+	for _, expr := range testCases {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := ParseCronExpression(expr); err == nil {
+				t.Fatalf("ParseCronExpression(%q): expected an error", expr)
+			}
+		})
+	}
+}
+
+func TestValidateCronExpression(t *testing.T) {
+	testCases := []struct {
+		value   interface{}
+		wantErr bool
+	}{
+		{value: "", wantErr: false},
+		{value: "0 1 * * *", wantErr: false},
+		{value: "0 0 12 ? * MON#2", wantErr: false},
+		{value: "cron", wantErr: true},
+		{value: 5, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		_, es := ValidateCronExpression(tc.value, "cron_expression")
+		if got := len(es) > 0; got != tc.wantErr {
+			t.Errorf("ValidateCronExpression(%v): got errors %v, want error %t", tc.value, es, tc.wantErr)
+		}
+	}
+}
+
+func TestCronNextExecutions(t *testing.T) {
+	if got := CronNextExecutions("*/5 * * * *"); len(got) != CronNextExecutionsCount {
+		t.Errorf("got %d runs, want %d", len(got), CronNextExecutionsCount)
+	}
+	if got := CronNextExecutions("invalid"); len(got) != 0 {
+		t.Errorf("got %d runs of an invalid expression, want none", len(got))
+	}
+	if got := CronNextExecutions(""); len(got) != 0 {
+		t.Errorf("got %d runs of an empty expression, want none", len(got))
+	}
+}
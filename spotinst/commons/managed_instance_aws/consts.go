@@ -7,6 +7,15 @@ const (
 	Description commons.FieldName = "description"
 	Region      commons.FieldName = "region"
 
+	WaitForRunningTimeout commons.FieldName = "wait_for_running_timeout"
+
+	// - Instance Status ----------------------
+	InstanceID        commons.FieldName = "instance_id"
+	InstancePrivateIP commons.FieldName = "instance_private_ip"
+	InstancePublicIP  commons.FieldName = "instance_public_ip"
+	Status            commons.FieldName = "status"
+	// ----------------------------------------
+
 	// - Instance Action ----------------------
 	ManagedInstanceAction commons.FieldName = "managed_instance_action"
 	ActionType            commons.FieldName = "type"
@@ -142,4 +142,98 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[WaitForRunningTimeout] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		WaitForRunningTimeout,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[InstanceID] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		InstanceID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			miWrapper := resourceObject.(*commons.MangedInstanceAWSWrapper)
+			var value *string = nil
+			if miWrapper.InstanceStatus != nil {
+				value = miWrapper.InstanceStatus.InstanceID
+			}
+			if err := resourceData.Set(string(InstanceID), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(InstanceID), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[InstancePrivateIP] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		InstancePrivateIP,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			miWrapper := resourceObject.(*commons.MangedInstanceAWSWrapper)
+			var value *string = nil
+			if miWrapper.InstanceStatus != nil {
+				value = miWrapper.InstanceStatus.PrivateIP
+			}
+			if err := resourceData.Set(string(InstancePrivateIP), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(InstancePrivateIP), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[InstancePublicIP] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		InstancePublicIP,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			miWrapper := resourceObject.(*commons.MangedInstanceAWSWrapper)
+			var value *string = nil
+			if miWrapper.InstanceStatus != nil {
+				value = miWrapper.InstanceStatus.PublicIP
+			}
+			if err := resourceData.Set(string(InstancePublicIP), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(InstancePublicIP), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[Status] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		Status,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			miWrapper := resourceObject.(*commons.MangedInstanceAWSWrapper)
+			var value *string = nil
+			if miWrapper.InstanceStatus != nil {
+				value = miWrapper.InstanceStatus.Status
+			}
+			if err := resourceData.Set(string(Status), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Status), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
 }
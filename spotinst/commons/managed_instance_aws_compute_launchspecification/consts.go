@@ -19,6 +19,7 @@ const (
 	KeyPair             commons.FieldName = "key_pair"
 	Tags                commons.FieldName = "tags"
 	UserData            commons.FieldName = "user_data"
+	UserDataBase64      commons.FieldName = "user_data_base64"
 	ShutdownScript      commons.FieldName = "shutdown_script"
 	CPUCredits          commons.FieldName = "cpu_credits"
 	BlockDeviceMappings commons.FieldName = "block_device_mappings"
@@ -7,4 +7,13 @@ const (
 	PersistRootDevice   commons.FieldName = "persist_root_device"
 	PersistPrivateIp    commons.FieldName = "persist_private_ip"
 	BlockDevicesMode    commons.FieldName = "block_devices_mode"
+
+	// - Deallocation -------------------------
+	StatefulDeallocation          commons.FieldName = "stateful_deallocation"
+	ShouldDeleteImages            commons.FieldName = "should_delete_images"
+	ShouldDeleteNetworkInterfaces commons.FieldName = "should_delete_network_interfaces"
+	ShouldDeleteVolumes           commons.FieldName = "should_delete_volumes"
+	ShouldDeleteSnapshots         commons.FieldName = "should_delete_snapshots"
+	ShouldTerminateInstance       commons.FieldName = "should_terminate_instance"
+	// ----------------------------------------
 )
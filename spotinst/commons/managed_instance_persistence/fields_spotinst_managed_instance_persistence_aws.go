@@ -176,6 +176,50 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[StatefulDeallocation] = commons.NewGenericField(
+		commons.ManagedInstanceAWSPersistence,
+		StatefulDeallocation,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(ShouldDeleteImages): {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					string(ShouldDeleteNetworkInterfaces): {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					string(ShouldDeleteVolumes): {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					string(ShouldDeleteSnapshots): {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					string(ShouldTerminateInstance): {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+		nil, nil, nil, nil,
+	)
 }
 
 func initPersistenceIfNeeded(managedInstance *aws.ManagedInstance) {
@@ -9,5 +9,6 @@ const (
 	Frequency      commons.FieldName = "frequency"
 	StartTime      commons.FieldName = "start_time"
 	CronExpression commons.FieldName = "cron_expression"
+	NextExecutions commons.FieldName = "next_executions"
 	TaskType       commons.FieldName = "task_type"
 )
@@ -36,10 +36,13 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(CronExpression): {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: commons.ValidateCronExpression,
 					},
 
+					string(NextExecutions): commons.NextExecutionsSchema(),
+
 					string(StartTime): {
 						Type:     schema.TypeString,
 						Optional: true,
@@ -103,6 +106,7 @@ func flattenAWSManagedInstanceScheduledTasks(tasks []*aws.Task) []interface{} {
 		m[string(IsEnabled)] = spotinst.BoolValue(t.IsEnabled)
 		m[string(TaskType)] = spotinst.StringValue(t.Type)
 		m[string(CronExpression)] = spotinst.StringValue(t.CronExpression)
+		m[string(NextExecutions)] = commons.CronNextExecutions(spotinst.StringValue(t.CronExpression))
 		m[string(StartTime)] = spotinst.StringValue(t.StartTime)
 		m[string(Frequency)] = spotinst.StringValue(t.Frequency)
 
@@ -0,0 +1,17 @@
+package managed_instance_state
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	ManagedInstanceID commons.FieldName = "managed_instance_id"
+	State             commons.FieldName = "state"
+	Timeout           commons.FieldName = "timeout"
+
+	// Computed fields
+	Status commons.FieldName = "status"
+)
+
+const (
+	StateRunning = commons.InstanceStateRunning
+	StatePaused  = commons.InstanceStatePaused
+)
@@ -0,0 +1,119 @@
+package managed_instance_state
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[ManagedInstanceID] = commons.NewGenericField(
+		commons.ManagedInstanceAWSState,
+		ManagedInstanceID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ManagedInstanceStateWrapper)
+			state := stateWrapper.GetManagedInstanceState()
+			if state.ManagedInstanceID != nil {
+				if err := resourceData.Set(string(ManagedInstanceID), spotinst.StringValue(state.ManagedInstanceID)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ManagedInstanceID), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ManagedInstanceStateWrapper)
+			state := stateWrapper.GetManagedInstanceState()
+			state.ManagedInstanceID = spotinst.String(resourceData.Get(string(ManagedInstanceID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[State] = commons.NewGenericField(
+		commons.ManagedInstanceAWSState,
+		State,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{StateRunning, StatePaused}, false),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ManagedInstanceStateWrapper)
+			state := stateWrapper.GetManagedInstanceState()
+			if state.State != nil {
+				if err := resourceData.Set(string(State), spotinst.StringValue(state.State)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(State), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ManagedInstanceStateWrapper)
+			state := stateWrapper.GetManagedInstanceState()
+			state.State = spotinst.String(resourceData.Get(string(State)).(string))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ManagedInstanceStateWrapper)
+			state := stateWrapper.GetManagedInstanceState()
+			state.State = spotinst.String(resourceData.Get(string(State)).(string))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Timeout] = commons.NewGenericField(
+		commons.ManagedInstanceAWSState,
+		Timeout,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      600,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ManagedInstanceStateWrapper)
+			state := stateWrapper.GetManagedInstanceState()
+			state.Timeout = spotinst.Int(resourceData.Get(string(Timeout)).(int))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ManagedInstanceStateWrapper)
+			state := stateWrapper.GetManagedInstanceState()
+			state.Timeout = spotinst.Int(resourceData.Get(string(Timeout)).(int))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Status] = commons.NewGenericField(
+		commons.ManagedInstanceAWSState,
+		Status,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ManagedInstanceStateWrapper)
+			state := stateWrapper.GetManagedInstanceState()
+			if err := resourceData.Set(string(Status), spotinst.StringValue(state.Status)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Status), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+}
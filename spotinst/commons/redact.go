@@ -0,0 +1,100 @@
+package commons
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// RedactedValue replaces the values of sensitive attributes in logged payloads.
+const RedactedValue = "<redacted>"
+
+var (
+	sensitiveKeysMu     sync.RWMutex
+	sensitiveKeys       = make(map[string]struct{})
+	sensitiveKeysRegexp *regexp.Regexp
+
+	authorizationRegexp = regexp.MustCompile(`(?im)^(Authorization:[ \t]*)[^\r\n]+`)
+)
+
+// RegisterSensitiveKeys marks attribute names whose values must never be
+// logged. Both the Terraform (`acl_token`) and the API (`aclToken`) spelling
+// of each name are registered.
+func RegisterSensitiveKeys(keys ...string) {
+	sensitiveKeysMu.Lock()
+	defer sensitiveKeysMu.Unlock()
+
+	for _, key := range keys {
+		sensitiveKeys[key] = struct{}{}
+		sensitiveKeys[toCamelCase(key)] = struct{}{}
+	}
+	sensitiveKeysRegexp = nil
+}
+
+// Redact replaces the values of sensitive attributes in a JSON payload, or in
+// an HTTP dump containing one, with RedactedValue. The Authorization header of
+// HTTP dumps is redacted as well.
+func Redact(s string) string {
+	s = authorizationRegexp.ReplaceAllString(s, "${1}"+RedactedValue)
+	if re := sensitiveKeysPattern(); re != nil {
+		s = re.ReplaceAllString(s, `${1}"`+RedactedValue+`"`)
+	}
+	return s
+}
+
+func sensitiveKeysPattern() *regexp.Regexp {
+	sensitiveKeysMu.RLock()
+	re := sensitiveKeysRegexp
+	sensitiveKeysMu.RUnlock()
+	if re != nil {
+		return re
+	}
+
+	sensitiveKeysMu.Lock()
+	defer sensitiveKeysMu.Unlock()
+
+	if sensitiveKeysRegexp == nil && len(sensitiveKeys) > 0 {
+		keys := make([]string, 0, len(sensitiveKeys))
+		for key := range sensitiveKeys {
+			keys = append(keys, regexp.QuoteMeta(key))
+		}
+		sort.Strings(keys)
+
+		// Matches `"key": "value"`, where value may contain escaped quotes.
+		sensitiveKeysRegexp = regexp.MustCompile(
+			`("(?:` + strings.Join(keys, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	}
+	return sensitiveKeysRegexp
+}
+
+// sensitiveSchemaKeys returns the names of the attributes of the schema,
+// including nested ones, that are marked Sensitive.
+func sensitiveSchemaKeys(name string, s *schema.Schema) []string {
+	var keys []string
+	if s == nil {
+		return keys
+	}
+	if s.Sensitive {
+		keys = append(keys, name)
+	}
+	if elem, ok := s.Elem.(*schema.Resource); ok {
+		for k, v := range elem.Schema {
+			keys = append(keys, sensitiveSchemaKeys(k, v)...)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func toCamelCase(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,195 @@
+package commons
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const minutesPerWeek = 7 * 24 * 60
+
+var (
+	timeWindowDays   = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+	timeWindowRegexp = regexp.MustCompile(`^([A-Za-z]{3}):(\d{2}):(\d{2})-([A-Za-z]{3}):(\d{2}):(\d{2})$`)
+)
+
+// TimeWindow is a weekly recurring window in the `ddd:hh:mm-ddd:hh:mm` format
+// used by shutdown hours and revert to spot, e.g. `Mon:20:00-Tue:07:00`.
+// Windows may wrap around the end of the week, e.g. `Fri:20:00-Mon:07:00`.
+// Start and End are minutes since Sunday 00:00.
+type TimeWindow struct {
+	Start, End int
+}
+
+// ParseTimeWindow parses a single time window. A window that ends before it
+// starts on the same day, or that has no length, is rejected as inverted.
+func ParseTimeWindow(value string) (*TimeWindow, error) {
+	m := timeWindowRegexp.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return nil, fmt.Errorf("invalid time window %q, expected ddd:hh:mm-ddd:hh:mm (e.g. Mon:20:00-Tue:07:00)", value)
+	}
+
+	start, err := parseTimeWindowPoint(m[1], m[2], m[3])
+	if err != nil {
+		return nil, fmt.Errorf("invalid time window %q: %v", value, err)
+	}
+	end, err := parseTimeWindowPoint(m[4], m[5], m[6])
+	if err != nil {
+		return nil, fmt.Errorf("invalid time window %q: %v", value, err)
+	}
+
+	if start == end || (start/(24*60) == end/(24*60) && end < start) {
+		return nil, fmt.Errorf("invalid time window %q: end must be after start", value)
+	}
+	return &TimeWindow{Start: start, End: end}, nil
+}
+
+func parseTimeWindowPoint(day, hour, minute string) (int, error) {
+	d := -1
+	for i, name := range timeWindowDays {
+		if strings.EqualFold(day, name) {
+			d = i
+			break
+		}
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid day %q, expected one of %s", day, strings.Join(timeWindowDays, ", "))
+	}
+	h, _ := strconv.Atoi(hour)
+	if h > 23 {
+		return 0, fmt.Errorf("invalid hour %q", hour)
+	}
+	mm, _ := strconv.Atoi(minute)
+	if mm > 59 {
+		return 0, fmt.Errorf("invalid minute %q", minute)
+	}
+	return d*24*60 + h*60 + mm, nil
+}
+
+// String returns the canonical representation of the window.
+func (w *TimeWindow) String() string {
+	return fmt.Sprintf("%s-%s", formatTimeWindowPoint(w.Start), formatTimeWindowPoint(w.End))
+}
+
+func formatTimeWindowPoint(m int) string {
+	return fmt.Sprintf("%s:%02d:%02d", timeWindowDays[m/(24*60)], m/60%24, m%60)
+}
+
+// Minutes returns the length of the window in minutes.
+func (w *TimeWindow) Minutes() int {
+	return ((w.End-w.Start)%minutesPerWeek + minutesPerWeek) % minutesPerWeek
+}
+
+// Overlaps reports whether the two windows share any time.
+func (w *TimeWindow) Overlaps(other *TimeWindow) bool {
+	contains := func(tw *TimeWindow, m int) bool {
+		return ((m-tw.Start)%minutesPerWeek+minutesPerWeek)%minutesPerWeek < tw.Minutes()
+	}
+	return contains(w, other.Start) || contains(other, w.Start)
+}
+
+// ParseTimeWindows parses a list of time windows, rejects overlapping windows
+// and returns them ordered by start time.
+func ParseTimeWindows(values []string) ([]*TimeWindow, error) {
+	windows := make([]*TimeWindow, 0, len(values))
+	for _, value := range values {
+		w, err := ParseTimeWindow(value)
+		if err != nil {
+			return nil, err
+		}
+		for _, other := range windows {
+			if w.Overlaps(other) {
+				return nil, fmt.Errorf("time windows %q and %q overlap", other.String(), w.String())
+			}
+		}
+		windows = append(windows, w)
+	}
+	sort.Slice(windows, func(i, j int) bool { return windows[i].Start < windows[j].Start })
+	return windows, nil
+}
+
+// NormalizeTimeWindows validates a list of time windows and returns their
+// canonical representations ordered by start time, as sent to the API.
+func NormalizeTimeWindows(values []string) ([]string, error) {
+	windows, err := ParseTimeWindows(values)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(windows))
+	for _, w := range windows {
+		result = append(result, w.String())
+	}
+	return result, nil
+}
+
+// TimeWindowsWeeklyHours returns the total number of hours per week covered by
+// the time windows. Windows that cannot be parsed are ignored.
+func TimeWindowsWeeklyHours(values []string) float64 {
+	minutes := 0
+	for _, value := range values {
+		if w, err := ParseTimeWindow(value); err == nil {
+			minutes += w.Minutes()
+		}
+	}
+	return float64(minutes) / 60
+}
+
+// ValidateTimeWindow is a schema.SchemaValidateFunc for a single time window.
+// Overlaps between windows are checked by the resources' CustomizeDiff.
+func ValidateTimeWindow(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+	if _, err := ParseTimeWindow(value); err != nil {
+		es = append(es, fmt.Errorf("%s: %v", k, err))
+	}
+	return
+}
+
+// HashTimeWindow hashes the canonical representation of a time window, so that
+// neither the order of the windows nor the case of the day names produce a diff.
+func HashTimeWindow(v interface{}) int {
+	value := v.(string)
+	if w, err := ParseTimeWindow(value); err == nil {
+		value = w.String()
+	}
+	return hashcode.String(value)
+}
+
+// TimeWindowsSchema returns the schema of a `time_windows` attribute.
+func TimeWindowsSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: required,
+		Optional: !required,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: ValidateTimeWindow,
+		},
+		Set: HashTimeWindow,
+	}
+}
+
+// ExpandTimeWindows converts a `time_windows` set into the normalized list
+// sent to the API.
+func ExpandTimeWindows(data interface{}) ([]string, error) {
+	var values []string
+	if set, ok := data.(*schema.Set); ok {
+		for _, v := range set.List() {
+			if s, ok := v.(string); ok && s != "" {
+				values = append(values, s)
+			}
+		}
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return NormalizeTimeWindows(values)
+}
@@ -0,0 +1,193 @@
+package commons
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// emptyUserDataSHA1 is the SHA1 sum of empty user data, which the EC2 API
+// sometimes responds with instead of an empty value.
+const emptyUserDataSHA1 = "da39a3ee5e6b4b0d3255bfef95601890afd80709"
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// IsBase64Encoded reports whether data is valid standard base64.
+func IsBase64Encoded(data string) bool {
+	_, err := base64.StdEncoding.DecodeString(data)
+	return err == nil
+}
+
+// Base64Encode encodes data using base64.StdEncoding. If the input is already
+// base64 encoded, it is returned unchanged. It is used by script attributes,
+// user data is encoded by EncodeUserData.
+func Base64Encode(data string) string {
+	if IsBase64Encoded(data) {
+		return data
+	}
+	return base64.StdEncoding.EncodeToString([]byte(data))
+}
+
+// EncodeUserData encodes plain text user data using base64.StdEncoding. Unlike
+// Base64Encode, it always encodes, so that user data which happens to be valid
+// base64 is sent as written. Base64 encoded user data goes in the
+// `user_data_base64` attribute.
+func EncodeUserData(data string) string {
+	return base64.StdEncoding.EncodeToString([]byte(data))
+}
+
+// Base64StateFunc stores a script attribute in its base64 encoded form.
+func Base64StateFunc(v interface{}) string {
+	return Base64Encode(v.(string))
+}
+
+// UserDataHash returns the SHA-256 sum of a base64 encoded user data payload,
+// which is stored in state instead of the payload itself.
+func UserDataHash(payload string) string {
+	if payload == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(payload))
+	return hex.EncodeToString(sum[:])
+}
+
+// UserDataStateFunc is the StateFunc of `user_data` attributes. The value is
+// stored as the hash of the payload sent to the API, so that `user_data` and
+// `user_data_base64` holding the same payload are stored alike.
+func UserDataStateFunc(v interface{}) string {
+	return UserDataHash(EncodeUserData(v.(string)))
+}
+
+// UserDataBase64StateFunc is the StateFunc of `user_data_base64` attributes.
+func UserDataBase64StateFunc(v interface{}) string {
+	return UserDataHash(v.(string))
+}
+
+// UserDataSchema returns the schema of a `user_data` attribute, which holds
+// user data as plain text. base64Key is the name of the matching
+// `user_data_base64` attribute.
+func UserDataSchema(base64Key FieldName) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		ConflictsWith:    []string{string(base64Key)},
+		DiffSuppressFunc: suppressUserDataDiff,
+		ValidateFunc:     validateUserData,
+		StateFunc:        UserDataStateFunc,
+	}
+}
+
+// UserDataBase64Schema returns the schema of a `user_data_base64` attribute,
+// which holds base64 encoded user data, optionally gzip compressed (e.g. using
+// the `base64gzip` function). key is the name of the matching `user_data`
+// attribute.
+func UserDataBase64Schema(key FieldName) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		ConflictsWith:    []string{string(key)},
+		DiffSuppressFunc: suppressUserDataDiff,
+		ValidateFunc:     validateUserDataBase64,
+		StateFunc:        UserDataBase64StateFunc,
+	}
+}
+
+// suppressUserDataDiff suppresses the diffs of user data whose value in state
+// is in a legacy form, so that upgrading the provider does not plan changes
+// before the next refresh stores the SHA-256 sum:
+//   - State used to hold the base64 encoded payload itself. new is the hash of
+//     the configured payload, so the diff is suppressed when old hashes to it.
+//   - The EC2 API sometimes responds with the SHA1 sum of empty user data,
+//     which is equivalent to no user data, both as stored by older versions
+//     and as hashed now.
+func suppressUserDataDiff(k, old, new string, d *schema.ResourceData) bool {
+	if old != "" && new != "" && UserDataHash(old) == new {
+		return true
+	}
+
+	isEmpty := func(v string) bool {
+		return v == "" || v == emptyUserDataSHA1 || v == UserDataHash(emptyUserDataSHA1)
+	}
+	return isEmpty(old) && isEmpty(new)
+}
+
+func validateUserData(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+	if value != "" && IsBase64Encoded(value) {
+		ws = append(ws, fmt.Sprintf("%s looks base64 encoded and is encoded again before it is sent, "+
+			"use the %s_base64 attribute for base64 encoded user data", k, k))
+	}
+	return
+}
+
+func validateUserDataBase64(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		es = append(es, fmt.Errorf("%s must be base64 encoded: %v", k, err))
+		return
+	}
+	if bytes.HasPrefix(decoded, gzipMagic) {
+		r, err := gzip.NewReader(bytes.NewReader(decoded))
+		if err == nil {
+			_, err = ioutil.ReadAll(r)
+		}
+		if err != nil {
+			es = append(es, fmt.Errorf("%s holds invalid gzip compressed data: %v", k, err))
+		}
+	}
+	return
+}
+
+// ExpandUserData returns the base64 encoded user data payload to send to the
+// API from either the `user_data` or the `user_data_base64` attribute, or nil
+// when neither is set.
+func ExpandUserData(resourceData *schema.ResourceData, key, base64Key FieldName) *string {
+	if v, ok := resourceData.Get(string(base64Key)).(string); ok && v != "" {
+		return &v
+	}
+	if v, ok := resourceData.Get(string(key)).(string); ok && v != "" {
+		payload := EncodeUserData(v)
+		return &payload
+	}
+	return nil
+}
+
+// FlattenUserData stores the hash of the user data payload read from the API
+// in the attribute it is configured in. Imported resources store it in
+// `user_data`.
+func FlattenUserData(resourceData *schema.ResourceData, key, base64Key FieldName, payload *string) error {
+	hash := ""
+	if payload != nil {
+		hash = UserDataHash(*payload)
+	}
+
+	userData, userDataBase64 := hash, ""
+	if v, ok := resourceData.Get(string(base64Key)).(string); ok && v != "" {
+		userData, userDataBase64 = "", hash
+	}
+
+	if err := resourceData.Set(string(key), userData); err != nil {
+		return fmt.Errorf(string(FailureFieldReadPattern), string(key), err)
+	}
+	if err := resourceData.Set(string(base64Key), userDataBase64); err != nil {
+		return fmt.Errorf(string(FailureFieldReadPattern), string(base64Key), err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package commons
+
+import "testing"
+
+func TestSuppressUserDataDiff(t *testing.T) {
+	payload := EncodeUserData("#!/bin/bash\necho hello")
+	otherPayload := EncodeUserData("#!/bin/bash\necho world")
+
+	testCases := []struct {
+		name     string
+		old, new string
+		want     bool
+	}{
+		{
+			name: "legacy payload in state",
+			old:  payload,
+			new:  UserDataHash(payload),
+			want: true,
+		},
+		{
+			name: "legacy payload in state, changed user data",
+			old:  payload,
+			new:  UserDataHash(otherPayload),
+			want: false,
+		},
+		{
+			name: "hash in state, changed user data",
+			old:  UserDataHash(payload),
+			new:  UserDataHash(otherPayload),
+			want: false,
+		},
+		{
+			name: "user data added",
+			old:  "",
+			new:  UserDataHash(payload),
+			want: false,
+		},
+		{
+			name: "user data removed",
+			old:  UserDataHash(payload),
+			new:  "",
+			want: false,
+		},
+		{
+			name: "legacy empty SHA1 sum in state",
+			old:  emptyUserDataSHA1,
+			new:  "",
+			want: true,
+		},
+		{
+			name: "hashed empty SHA1 sum in state",
+			old:  UserDataHash(emptyUserDataSHA1),
+			new:  "",
+			want: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := suppressUserDataDiff("user_data", tc.old, tc.new, nil); got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
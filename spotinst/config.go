@@ -16,10 +16,12 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/ocean"
 	"github.com/spotinst/spotinst-sdk-go/service/subscription"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	spotinstclient "github.com/spotinst/spotinst-sdk-go/spotinst/client"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/featureflag"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 	"github.com/spotinst/terraform-provider-spotinst/version"
 )
 
@@ -44,6 +46,10 @@ type Client struct {
 	mrscaler        mrscaler.Service
 	ocean           ocean.Service
 	managedInstance managedinstance.Service
+
+	// api sends requests with fields that spotinst-sdk-go types lack, to
+	// endpoints spotinst-sdk-go itself calls.
+	api *spotinstclient.Client
 }
 
 // Client configures and returns a fully initialized Spotinst client.
@@ -65,6 +71,7 @@ func (c *Config) Client() (*Client, error) {
 		mrscaler:        mrscaler.New(sess),
 		ocean:           ocean.New(sess),
 		managedInstance: managedinstance.New(sess),
+		api:             spotinstclient.New(sess.Config),
 	}
 
 	stdlog.Println("[INFO] Spotinst client configured")
@@ -92,7 +99,10 @@ func (c *Config) getSession() (*session.Session, error) {
 	// Logging.
 	{
 		config.WithLogger(log.LoggerFunc(func(format string, args ...interface{}) {
-			stdlog.Printf(fmt.Sprintf("[DEBUG] [spotinst-sdk-go] %s", format), args...)
+			// Requests and responses are dumped with their credentials and
+			// payloads, redact them before they reach the Terraform log.
+			msg := commons.Redact(fmt.Sprintf(format, args...))
+			stdlog.Printf("[DEBUG] [spotinst-sdk-go] %s", msg)
 		}))
 	}
 
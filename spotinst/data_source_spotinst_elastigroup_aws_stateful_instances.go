@@ -0,0 +1,153 @@
+package spotinst
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func dataSourceSpotinstElastigroupAWSStatefulInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSpotinstElastigroupAWSStatefulInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"elastigroup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"stateful_instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"image_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"launched_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"devices": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"device_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"volume_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"snapshot_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstElastigroupAWSStatefulInstancesRead(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.DataSourceOnRead), commons.ElastigroupAWSStatefulInstancesDataSourceName)
+
+	groupID := resourceData.Get("elastigroup_id").(string)
+	state := resourceData.Get("state").(string)
+
+	resp, err := listElastigroupAWSStatefulInstances(groupID, meta.(*Client))
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(resp))
+	statefulInstances := make([]interface{}, 0, len(resp))
+	for _, si := range resp {
+		if state != "" && !strings.EqualFold(spotinst.StringValue(si.State), state) {
+			continue
+		}
+
+		devices := make([]interface{}, 0, len(si.Devices))
+		for _, device := range si.Devices {
+			devices = append(devices, map[string]interface{}{
+				"device_name": spotinst.StringValue(device.DeviceName),
+				"volume_id":   spotinst.StringValue(device.VolumeID),
+				"snapshot_id": spotinst.StringValue(device.SnapshotID),
+			})
+		}
+
+		ids = append(ids, spotinst.StringValue(si.StatefulInstanceID))
+		statefulInstances = append(statefulInstances, map[string]interface{}{
+			"id":          spotinst.StringValue(si.StatefulInstanceID),
+			"instance_id": spotinst.StringValue(si.InstanceID),
+			"state":       spotinst.StringValue(si.State),
+			"private_ip":  spotinst.StringValue(si.PrivateIP),
+			"image_id":    spotinst.StringValue(si.ImageID),
+			"created_at":  spotinst.StringValue(si.CreatedAt),
+			"launched_at": spotinst.StringValue(si.LaunchedAt),
+			"devices":     devices,
+		})
+	}
+
+	resourceData.SetId(groupID)
+	if err := resourceData.Set("ids", ids); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), "ids", err)
+	}
+	if err := resourceData.Set("stateful_instances", statefulInstances); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), "stateful_instances", err)
+	}
+
+	log.Printf("===> Stateful instances found successfully: %d <===", len(ids))
+	return nil
+}
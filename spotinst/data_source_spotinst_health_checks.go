@@ -0,0 +1,156 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/healthcheck"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func dataSourceSpotinstHealthChecks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSpotinstHealthChecksRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"health_checks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"proxy_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"proxy_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"interval": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"healthy": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"unhealthy": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstHealthChecksRead(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.DataSourceOnRead), commons.HealthChecksDataSourceName)
+
+	resp, err := meta.(*Client).healthCheck.List(context.Background(), &healthcheck.ListHealthChecksInput{})
+	if err != nil {
+		return fmt.Errorf("failed to list health checks: %s", err)
+	}
+
+	resourceID := resourceData.Get("resource_id").(string)
+	name := resourceData.Get("name").(string)
+
+	ids := make([]string, 0, len(resp.HealthChecks))
+	healthChecks := make([]interface{}, 0, len(resp.HealthChecks))
+	for _, hc := range resp.HealthChecks {
+		if spotinst.StringValue(hc.ResourceID) != resourceID {
+			continue
+		}
+		if name != "" && spotinst.StringValue(hc.Name) != name {
+			continue
+		}
+		m := map[string]interface{}{
+			"id":            spotinst.StringValue(hc.ID),
+			"name":          spotinst.StringValue(hc.Name),
+			"resource_id":   spotinst.StringValue(hc.ResourceID),
+			"proxy_address": spotinst.StringValue(hc.ProxyAddr),
+			"proxy_port":    spotinst.IntValue(hc.ProxyPort),
+		}
+		if check := hc.Check; check != nil {
+			m["protocol"] = spotinst.StringValue(check.Protocol)
+			m["endpoint"] = spotinst.StringValue(check.Endpoint)
+			m["port"] = spotinst.IntValue(check.Port)
+			m["interval"] = spotinst.IntValue(check.Interval)
+			m["timeout"] = spotinst.IntValue(check.Timeout)
+			m["healthy"] = spotinst.IntValue(check.Healthy)
+			m["unhealthy"] = spotinst.IntValue(check.Unhealthy)
+		}
+		ids = append(ids, spotinst.StringValue(hc.ID))
+		healthChecks = append(healthChecks, m)
+	}
+
+	resourceData.SetId(resourceID)
+	if err := resourceData.Set("ids", ids); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), "ids", err)
+	}
+	if err := resourceData.Set("health_checks", healthChecks); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), "health_checks", err)
+	}
+
+	log.Printf("===> Health checks found successfully: %d <===", len(ids))
+	return nil
+}
@@ -0,0 +1,181 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/mrscaler"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/mrscaler_aws"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/mrscaler_aws_instance_groups"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/mrscaler_aws_strategy"
+)
+
+func dataSourceSpotinstMRScalerAWS() *schema.Resource {
+	setupMRScalerAWSResource()
+
+	s := dataSourceSchemaFromResourceSchema(commons.MRScalerAWSResource.GetSchemaMap())
+	delete(s, string(mrscaler_aws.ExposeClusterID))
+	delete(s, string(mrscaler_aws.WaitForSteps))
+	delete(s, string(mrscaler_aws.WaitForStepsTimeout))
+
+	s["scaler_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"scaler_id", string(mrscaler_aws.Name)},
+	}
+	s[string(mrscaler_aws.Name)] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"scaler_id", string(mrscaler_aws.Name)},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceSpotinstMRScalerAWSRead,
+		Schema: s,
+	}
+}
+
+// dataSourceSchemaFromResourceSchema returns a copy of a resource schema in
+// which every attribute is computed, to expose the resource as a data source.
+func dataSourceSchemaFromResourceSchema(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(rs))
+	for k, v := range rs {
+		dv := &schema.Schema{
+			Type:        v.Type,
+			Computed:    true,
+			Description: v.Description,
+			Set:         v.Set,
+		}
+		switch elem := v.Elem.(type) {
+		case *schema.Resource:
+			dv.Elem = &schema.Resource{Schema: dataSourceSchemaFromResourceSchema(elem.Schema)}
+		case *schema.Schema:
+			dv.Elem = &schema.Schema{Type: elem.Type}
+		}
+		ds[k] = dv
+	}
+	return ds
+}
+
+func dataSourceSpotinstMRScalerAWSRead(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.DataSourceOnRead), commons.MRScalerAWSDataSourceName)
+
+	client := meta.(*Client)
+	scalerID := resourceData.Get("scaler_id").(string)
+	if scalerID == "" {
+		id, err := findMRScalerAWSByName(resourceData.Get(string(mrscaler_aws.Name)).(string), client)
+		if err != nil {
+			return err
+		}
+		scalerID = id
+	}
+
+	resp, err := client.mrscaler.Read(context.Background(), &mrscaler.ReadScalerInput{ScalerID: spotinst.String(scalerID)})
+	if err != nil {
+		return fmt.Errorf("failed to read mr scaler: %s", err)
+	}
+	scaler := resp.Scaler
+	if scaler == nil {
+		return fmt.Errorf("mr scaler not found: %s", scalerID)
+	}
+	if scaler.Compute == nil {
+		scaler.SetCompute(&mrscaler.Compute{})
+	}
+
+	resourceData.SetId(scalerID)
+	if err := resourceData.Set("scaler_id", scalerID); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), "scaler_id", err)
+	}
+	// The cluster is read on a best-effort basis, it may not be up yet.
+	cluster, err := readMRScalerAWSCluster(scalerID, client)
+	if err != nil {
+		log.Printf("[WARN] failed to read cluster of MRScaler %s: %s", scalerID, err)
+	}
+	if err := commons.MRScalerAWSResource.OnRead(scaler, cluster, resourceData, meta); err != nil {
+		return err
+	}
+	if err := flattenMRScalerAWSConfiguredFields(scaler, resourceData); err != nil {
+		return err
+	}
+	if err := exposeMrScalerClusterId(resourceData, cluster); err != nil {
+		return err
+	}
+
+	log.Printf("===> MRScaler found successfully: %s <===", resourceData.Id())
+	return nil
+}
+
+func findMRScalerAWSByName(name string, client *Client) (string, error) {
+	resp, err := client.mrscaler.List(context.Background(), &mrscaler.ListScalersInput{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list mr scalers: %s", err)
+	}
+
+	var ids []string
+	for _, scaler := range resp.Scalers {
+		if spotinst.StringValue(scaler.Name) == name {
+			ids = append(ids, spotinst.StringValue(scaler.ID))
+		}
+	}
+
+	if len(ids) == 0 {
+		return "", fmt.Errorf("no mr scaler found with name %q", name)
+	}
+	if len(ids) > 1 {
+		return "", fmt.Errorf("%d mr scalers found with name %q, use scaler_id instead", len(ids), name)
+	}
+	return ids[0], nil
+}
+
+// flattenMRScalerAWSConfiguredFields sets the fields whose resource readers
+// keep the configured value, i.e. the strategy and the task group.
+func flattenMRScalerAWSConfiguredFields(scaler *mrscaler.Scaler, resourceData *schema.ResourceData) error {
+	fields := make(map[string]interface{})
+
+	if strategy := scaler.Strategy; strategy != nil {
+		switch {
+		case strategy.Wrapping != nil:
+			fields[string(mrscaler_aws_strategy.Strategy)] = mrscaler_aws_strategy.Wrap
+		case strategy.Cloning != nil:
+			fields[string(mrscaler_aws_strategy.Strategy)] = mrscaler_aws_strategy.Clone
+			fields[string(mrscaler_aws_strategy.Retries)] = spotinst.IntValue(strategy.Cloning.Retries)
+		case strategy.CreateNew != nil:
+			fields[string(mrscaler_aws_strategy.Strategy)] = mrscaler_aws_strategy.New
+			fields[string(mrscaler_aws_strategy.ReleaseLabel)] = spotinst.StringValue(strategy.CreateNew.ReleaseLabel)
+			fields[string(mrscaler_aws_strategy.Retries)] = spotinst.IntValue(strategy.CreateNew.Retries)
+		}
+	}
+
+	if scaler.Compute.InstanceGroups != nil && scaler.Compute.InstanceGroups.TaskGroup != nil {
+		group := scaler.Compute.InstanceGroups.TaskGroup
+		fields[string(mrscaler_aws_instance_groups.TaskInstanceTypes)] = group.InstanceTypes
+		fields[string(mrscaler_aws_instance_groups.TaskLifecycle)] = spotinst.StringValue(group.LifeCycle)
+		if group.Capacity != nil {
+			fields[string(mrscaler_aws_instance_groups.TaskMin)] = spotinst.IntValue(group.Capacity.Minimum)
+			fields[string(mrscaler_aws_instance_groups.TaskMax)] = spotinst.IntValue(group.Capacity.Maximum)
+			fields[string(mrscaler_aws_instance_groups.TaskTarget)] = spotinst.IntValue(group.Capacity.Target)
+			fields[string(mrscaler_aws_instance_groups.TaskUnit)] = spotinst.StringValue(group.Capacity.Unit)
+		}
+	}
+
+	if scaler.Compute.InstanceGroups != nil && scaler.Compute.InstanceGroups.CoreGroup != nil {
+		group := scaler.Compute.InstanceGroups.CoreGroup
+		fields[string(mrscaler_aws_instance_groups.CoreInstanceTypes)] = group.InstanceTypes
+		if group.Capacity != nil {
+			fields[string(mrscaler_aws_instance_groups.CoreUnit)] = spotinst.StringValue(group.Capacity.Unit)
+		}
+	}
+
+	for k, v := range fields {
+		if err := resourceData.Set(k, v); err != nil {
+			return fmt.Errorf(string(commons.FailureFieldReadPattern), k, err)
+		}
+	}
+	return nil
+}
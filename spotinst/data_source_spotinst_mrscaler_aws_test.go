@@ -0,0 +1,49 @@
+package spotinst
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccSpotinstMRScalerAWSDataSource_Baseline(t *testing.T) {
+	scalerName := "mrscaler-data-source"
+	resourceName := createMRScalerAWSResourceName(scalerName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testMRScalerAWSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createMRScalerAWSTerraform(&MRScalerAWSConfigMetaData{
+					scalerName: scalerName,
+					newCluster: true,
+				}) + fmt.Sprintf(testMRScalerAWSDataSourceConfig, resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.spotinst_mrscaler_aws.by_id", "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair("data.spotinst_mrscaler_aws.by_name", "id", resourceName, "id"),
+					resource.TestCheckResourceAttr("data.spotinst_mrscaler_aws.by_id", "strategy", "new"),
+					resource.TestCheckResourceAttr("data.spotinst_mrscaler_aws.by_id", "release_label", "emr-5.0.3"),
+					resource.TestCheckResourceAttrPair("data.spotinst_mrscaler_aws.by_id", "core_desired_capacity", resourceName, "core_desired_capacity"),
+					resource.TestCheckResourceAttrPair("data.spotinst_mrscaler_aws.by_id", "task_desired_capacity", resourceName, "task_desired_capacity"),
+					resource.TestCheckResourceAttrSet("data.spotinst_mrscaler_aws.by_id", "output_cluster_id"),
+				),
+			},
+		},
+	})
+}
+
+const testMRScalerAWSDataSourceConfig = `
+data "spotinst_mrscaler_aws" "by_id" {
+  provider  = "aws"
+  scaler_id = "${%[1]v.id}"
+}
+
+data "spotinst_mrscaler_aws" "by_name" {
+  provider = "aws"
+  name     = "${%[1]v.name}"
+}
+`
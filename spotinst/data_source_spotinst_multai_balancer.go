@@ -0,0 +1,121 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func dataSourceSpotinstMultaiBalancer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSpotinstMultaiBalancerRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"scheme": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dns_cname_aliases": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstMultaiBalancerRead(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.DataSourceOnRead), commons.MultaiBalancerDataSourceName)
+
+	input := &multai.ListLoadBalancersInput{}
+	if v, ok := resourceData.GetOk("deployment_id"); ok {
+		input.DeploymentID = spotinst.String(v.(string))
+	}
+
+	resp, err := meta.(*Client).multai.ListLoadBalancers(context.Background(), input)
+	if err != nil {
+		return fmt.Errorf("failed to list balancers: %s", err)
+	}
+
+	name := resourceData.Get("name").(string)
+	tags := resourceData.Get("tags").(map[string]interface{})
+
+	var balancers []*multai.LoadBalancer
+	for _, balancer := range resp.Balancers {
+		if name != "" && spotinst.StringValue(balancer.Name) != name {
+			continue
+		}
+		if !multaiTagsMatch(balancer.Tags, tags) {
+			continue
+		}
+		balancers = append(balancers, balancer)
+	}
+
+	if len(balancers) == 0 {
+		return fmt.Errorf("no balancer found matching the given filters")
+	}
+	if len(balancers) > 1 {
+		return fmt.Errorf("%d balancers found matching the given filters, use a more specific filter", len(balancers))
+	}
+
+	balancer := balancers[0]
+	resourceData.SetId(spotinst.StringValue(balancer.ID))
+	if err := resourceData.Set("name", spotinst.StringValue(balancer.Name)); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), "name", err)
+	}
+	if err := resourceData.Set("scheme", spotinst.StringValue(balancer.Scheme)); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), "scheme", err)
+	}
+	if err := resourceData.Set("dns_cname_aliases", balancer.DNSCNAMEAliases); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), "dns_cname_aliases", err)
+	}
+	if err := resourceData.Set("tags", flattenMultaiTagsMap(balancer.Tags)); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), "tags", err)
+	}
+
+	log.Printf("===> Balancer found successfully: %s <===", resourceData.Id())
+	return nil
+}
+
+// multaiTagsMatch reports whether tags holds every key and value of filter.
+func multaiTagsMatch(tags []*multai.Tag, filter map[string]interface{}) bool {
+	values := flattenMultaiTagsMap(tags)
+	for k, v := range filter {
+		if value, ok := values[k]; !ok || value != v.(string) {
+			return false
+		}
+	}
+	return true
+}
+
+func flattenMultaiTagsMap(tags []*multai.Tag) map[string]string {
+	result := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		result[spotinst.StringValue(tag.Key)] = spotinst.StringValue(tag.Value)
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func dataSourceSpotinstMultaiDeployment() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSpotinstMultaiDeploymentRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstMultaiDeploymentRead(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.DataSourceOnRead), commons.MultaiDeploymentDataSourceName)
+
+	resp, err := meta.(*Client).multai.ListDeployments(context.Background(), &multai.ListDeploymentsInput{})
+	if err != nil {
+		return fmt.Errorf("failed to list deployments: %s", err)
+	}
+
+	name := resourceData.Get("name").(string)
+
+	var deployments []*multai.Deployment
+	for _, deployment := range resp.Deployments {
+		if spotinst.StringValue(deployment.Name) == name {
+			deployments = append(deployments, deployment)
+		}
+	}
+
+	if len(deployments) == 0 {
+		return fmt.Errorf("no deployment found with name %q", name)
+	}
+	if len(deployments) > 1 {
+		return fmt.Errorf("%d deployments found with name %q", len(deployments), name)
+	}
+
+	deployment := deployments[0]
+	resourceData.SetId(spotinst.StringValue(deployment.ID))
+	if err := resourceData.Set("tags", flattenMultaiTagsMap(deployment.Tags)); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), "tags", err)
+	}
+
+	log.Printf("===> Deployment found successfully: %s <===", resourceData.Id())
+	return nil
+}
@@ -0,0 +1,113 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func dataSourceSpotinstMultaiListeners() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSpotinstMultaiListenersRead,
+
+		Schema: map[string]*schema.Schema{
+			"balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"listeners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstMultaiListenersRead(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.DataSourceOnRead), commons.MultaiListenersDataSourceName)
+
+	balancerID := resourceData.Get("balancer_id").(string)
+	input := &multai.ListListenersInput{BalancerID: spotinst.String(balancerID)}
+	resp, err := meta.(*Client).multai.ListListeners(context.Background(), input)
+	if err != nil {
+		return fmt.Errorf("failed to list listeners of balancer %s: %s", balancerID, err)
+	}
+
+	protocol := resourceData.Get("protocol").(string)
+	port := resourceData.Get("port").(int)
+
+	ids := make([]string, 0, len(resp.Listeners))
+	listeners := make([]interface{}, 0, len(resp.Listeners))
+	for _, listener := range resp.Listeners {
+		if protocol != "" && spotinst.StringValue(listener.Protocol) != protocol {
+			continue
+		}
+		if port != 0 && spotinst.IntValue(listener.Port) != port {
+			continue
+		}
+		ids = append(ids, spotinst.StringValue(listener.ID))
+		listeners = append(listeners, map[string]interface{}{
+			"id":       spotinst.StringValue(listener.ID),
+			"protocol": spotinst.StringValue(listener.Protocol),
+			"port":     spotinst.IntValue(listener.Port),
+			"tags":     flattenMultaiTagsMap(listener.Tags),
+		})
+	}
+
+	resourceData.SetId(balancerID)
+	if err := resourceData.Set("ids", ids); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), "ids", err)
+	}
+	if err := resourceData.Set("listeners", listeners); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), "listeners", err)
+	}
+
+	log.Printf("===> Listeners found successfully: %d <===", len(ids))
+	return nil
+}
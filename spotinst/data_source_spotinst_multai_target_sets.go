@@ -0,0 +1,147 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func dataSourceSpotinstMultaiTargetSets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSpotinstMultaiTargetSetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"target_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"balancer_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"deployment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstMultaiTargetSetsRead(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.DataSourceOnRead), commons.MultaiTargetSetsDataSourceName)
+
+	balancerID := resourceData.Get("balancer_id").(string)
+	input := &multai.ListTargetSetsInput{BalancerID: spotinst.String(balancerID)}
+	resp, err := meta.(*Client).multai.ListTargetSets(context.Background(), input)
+	if err != nil {
+		return fmt.Errorf("failed to list target sets of balancer %s: %s", balancerID, err)
+	}
+
+	deploymentID := resourceData.Get("deployment_id").(string)
+	name := resourceData.Get("name").(string)
+	tags := resourceData.Get("tags").(map[string]interface{})
+
+	ids := make([]string, 0, len(resp.TargetSets))
+	targetSets := make([]interface{}, 0, len(resp.TargetSets))
+	for _, set := range resp.TargetSets {
+		if deploymentID != "" && spotinst.StringValue(set.DeploymentID) != deploymentID {
+			continue
+		}
+		if name != "" && spotinst.StringValue(set.Name) != name {
+			continue
+		}
+		if !multaiTagsMatch(set.Tags, tags) {
+			continue
+		}
+		ids = append(ids, spotinst.StringValue(set.ID))
+		targetSets = append(targetSets, map[string]interface{}{
+			"id":            spotinst.StringValue(set.ID),
+			"balancer_id":   spotinst.StringValue(set.BalancerID),
+			"deployment_id": spotinst.StringValue(set.DeploymentID),
+			"name":          spotinst.StringValue(set.Name),
+			"protocol":      spotinst.StringValue(set.Protocol),
+			"port":          spotinst.IntValue(set.Port),
+			"weight":        spotinst.IntValue(set.Weight),
+			"tags":          flattenMultaiTagsMap(set.Tags),
+		})
+	}
+
+	resourceData.SetId(balancerID)
+	if err := resourceData.Set("ids", ids); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), "ids", err)
+	}
+	if err := resourceData.Set("target_sets", targetSets); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), "target_sets", err)
+	}
+
+	log.Printf("===> Target sets found successfully: %d <===", len(ids))
+	return nil
+}
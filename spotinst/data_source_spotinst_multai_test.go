@@ -0,0 +1,120 @@
+package spotinst
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccSpotinstMultaiDataSources_Baseline(t *testing.T) {
+	name := "test-acc-multai-data-sources"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t, "aws") },
+		Providers: TestAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testBaselineMultaiDataSourcesConfig, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.spotinst_multai_balancer.by_name", "id", "spotinst_multai_balancer.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.spotinst_multai_balancer.by_tags", "id", "spotinst_multai_balancer.foo", "id"),
+					resource.TestCheckResourceAttr("data.spotinst_multai_balancer.by_name", "tags.team", "networking"),
+					resource.TestCheckResourceAttrPair("data.spotinst_multai_deployment.foo", "id", "spotinst_multai_deployment.foo", "id"),
+					resource.TestCheckResourceAttr("data.spotinst_multai_listeners.foo", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.spotinst_multai_listeners.foo", "ids.0", "spotinst_multai_listener.foo", "id"),
+					resource.TestCheckResourceAttr("data.spotinst_multai_listeners.foo", "listeners.0.port", "1337"),
+					resource.TestCheckResourceAttr("data.spotinst_multai_target_sets.foo", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.spotinst_multai_target_sets.foo", "ids.0", "spotinst_multai_target_set.foo", "id"),
+					resource.TestCheckResourceAttr("data.spotinst_multai_target_sets.foo", "target_sets.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineMultaiDataSourcesConfig = `
+provider "aws" {
+ token   = "fake"
+ account = "fake"
+}
+
+resource "spotinst_multai_deployment" "foo" {
+  provider = "aws"
+  name     = "%[1]v"
+}
+
+resource "spotinst_multai_balancer" "foo" {
+  provider = "aws"
+  name     = "%[1]v"
+
+  connection_timeouts {
+    idle     = 10
+    draining = 10
+  }
+
+  tags {
+    key   = "team"
+    value = "networking"
+  }
+}
+
+resource "spotinst_multai_listener" "foo" {
+  provider    = "aws"
+  balancer_id = "${spotinst_multai_balancer.foo.id}"
+  protocol    = "http"
+  port        = 1337
+}
+
+resource "spotinst_multai_target_set" "foo" {
+  provider      = "aws"
+  name          = "%[1]v"
+  balancer_id   = "${spotinst_multai_balancer.foo.id}"
+  deployment_id = "${spotinst_multai_deployment.foo.id}"
+  protocol      = "http"
+  port          = 1337
+  weight        = 1
+
+  health_check {
+    protocol            = "http"
+    path                = "/"
+    port                = 3000
+    interval            = 30
+    timeout             = 10
+    healthy_threshold   = 2
+    unhealthy_threshold = 2
+  }
+}
+
+data "spotinst_multai_deployment" "foo" {
+  provider = "aws"
+  name     = "${spotinst_multai_deployment.foo.name}"
+}
+
+data "spotinst_multai_balancer" "by_name" {
+  provider = "aws"
+  name     = "${spotinst_multai_balancer.foo.name}"
+}
+
+data "spotinst_multai_balancer" "by_tags" {
+  provider = "aws"
+  name     = "${spotinst_multai_balancer.foo.name}"
+
+  tags = {
+    team = "networking"
+  }
+}
+
+data "spotinst_multai_listeners" "foo" {
+  provider    = "aws"
+  balancer_id = "${spotinst_multai_listener.foo.balancer_id}"
+  port        = 1337
+}
+
+data "spotinst_multai_target_sets" "foo" {
+  provider    = "aws"
+  balancer_id = "${spotinst_multai_target_set.foo.balancer_id}"
+  name        = "${spotinst_multai_target_set.foo.name}"
+}
+`
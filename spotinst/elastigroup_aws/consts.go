@@ -10,6 +10,12 @@ const (
 	BalancerTypeMultaiTargetSet BalancerType = "MULTAI_TARGET_SET"
 )
 
+const (
+	PerformAtNever      = "never"
+	PerformAtAlways     = "always"
+	PerformAtTimeWindow = "timeWindow"
+)
+
 type TagField string
 
 const (
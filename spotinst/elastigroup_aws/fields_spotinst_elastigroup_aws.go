@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -623,6 +624,8 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	// Balancer fields share the load balancers list of the group, which each of
+	// them prepends to, so their order is fixed to keep requests reproducible.
 	fieldsMap[TargetGroupArns] = commons.NewGenericField(
 		commons.ElastigroupAWS,
 		TargetGroupArns,
@@ -689,7 +692,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		nil,
-	)
+	).SetDependencies(ElasticLoadBalancers)
 
 	fieldsMap[MultaiTargetSets] = commons.NewGenericField(
 		commons.ElastigroupAWS,
@@ -748,7 +751,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		nil,
-	)
+	).SetDependencies(TargetGroupArns)
 
 	fieldsMap[Tags] = commons.NewGenericField(
 		commons.ElastigroupAWS,
@@ -877,13 +880,14 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					string(PerformAt): {
 						Type:     schema.TypeString,
 						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							PerformAtNever,
+							PerformAtAlways,
+							PerformAtTimeWindow,
+						}, false),
 					},
 
-					string(TimeWindow): {
-						Type:     schema.TypeList,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
+					string(TimeWindow): commons.TimeWindowsSchema(false),
 				},
 			},
 		},
@@ -1459,15 +1463,9 @@ func expandAWSGroupRevertToSpot(data interface{}) (*aws.RevertToSpot, error) {
 		}
 		revertToSpot.SetPerformAt(performAt)
 
-		var timeWindows []string = nil
-		if v, ok := m[string(TimeWindow)].([]interface{}); ok && len(v) > 0 {
-			ids := make([]string, 0, len(v))
-			for _, id := range v {
-				if v, ok := id.(string); ok && len(v) > 0 {
-					ids = append(ids, v)
-				}
-			}
-			timeWindows = ids
+		timeWindows, err := commons.ExpandTimeWindows(m[string(TimeWindow)])
+		if err != nil {
+			return nil, err
 		}
 		revertToSpot.SetTimeWindows(timeWindows)
 	}
@@ -1510,3 +1508,29 @@ func extractTargetGroupFromArn(arn string) (string, error) {
 	}
 	return name, nil
 }
+
+// ValidateRevertToSpot is a schema.CustomizeDiffFunc that rejects malformed,
+// inverted or overlapping revert to spot time windows at plan time, and checks
+// that time windows are set exactly when they are used.
+func ValidateRevertToSpot(diff *schema.ResourceDiff, meta interface{}) error {
+	list, ok := diff.Get(string(RevertToSpot)).([]interface{})
+	if !ok || len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]interface{})
+
+	timeWindows, err := commons.ExpandTimeWindows(m[string(TimeWindow)])
+	if err != nil {
+		return fmt.Errorf("%s.%s: %v", string(RevertToSpot), string(TimeWindow), err)
+	}
+
+	switch performAt, _ := m[string(PerformAt)].(string); {
+	case performAt == PerformAtTimeWindow && len(timeWindows) == 0:
+		return fmt.Errorf("%s: %s is required when %s is %q",
+			string(RevertToSpot), string(TimeWindow), string(PerformAt), PerformAtTimeWindow)
+	case performAt != "" && performAt != PerformAtTimeWindow && len(timeWindows) > 0:
+		return fmt.Errorf("%s: %s can only be set when %s is %q",
+			string(RevertToSpot), string(TimeWindow), string(PerformAt), PerformAtTimeWindow)
+	}
+	return nil
+}
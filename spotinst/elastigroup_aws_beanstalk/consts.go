@@ -16,7 +16,6 @@ const (
 	BeanstalkEnvironmentName commons.FieldName = "beanstalk_environment_name"
 	BeanstalkEnvironmentId   commons.FieldName = "beanstalk_environment_id"
 	SpotInstanceTypes        commons.FieldName = "instance_types_spot"
-	Maintenance              commons.FieldName = "maintenance"
 	ManagedActions           commons.FieldName = "managed_actions"
 	PlatformUpdate           commons.FieldName = "platform_update"
 	PerformAt                commons.FieldName = "perform_at"
@@ -30,3 +29,17 @@ const (
 	Action                   commons.FieldName = "action"
 	ShouldDrainInstances     commons.FieldName = "should_drain_instances"
 )
+
+const (
+	// - Maintenance --------------------------
+	Maintenance        commons.FieldName = "maintenance"
+	MaintenanceEnabled commons.FieldName = "enabled"
+	MaintenanceTimeout commons.FieldName = "timeout"
+	MaintenanceStatus  commons.FieldName = "status"
+	// ----------------------------------------
+)
+
+const (
+	MaintenanceStatusActive          = "ACTIVE"
+	MaintenanceStatusAwaitUserUpdate = "AWAIT_USER_UPDATE"
+)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -330,29 +331,71 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.ElastigroupAWSBeanstalk,
 		Maintenance,
 		&schema.Schema{
-			Type:     schema.TypeString,
+			Type:     schema.TypeList,
 			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(MaintenanceEnabled): {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+
+					string(MaintenanceTimeout): {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1800,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					string(MaintenanceStatus): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			return nil
-		},
-		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			if v, ok := resourceData.GetOk(string(Maintenance)); ok && v != nil {
-				if v != "START" && v != "END" && v != "STATUS" {
-					return fmt.Errorf("error: maintenance mode must be START, END, or STATUS")
-				}
+			beanstalkWrapper := resourceObject.(*commons.ElastigroupAWSBeanstalkWrapper)
+			if beanstalkWrapper.MaintenanceStatus == nil {
+				return nil
 			}
-			return nil
-		},
-		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			if v, ok := resourceData.GetOk(string(Maintenance)); ok && v != nil {
-				if v != "START" && v != "END" && v != "STATUS" {
-					return fmt.Errorf("error: maintenance mode must be START or END, or STATUS")
+
+			enabled, timeout := false, 1800
+			if v, ok := resourceData.GetOk(string(Maintenance)); ok {
+				if list := v.([]interface{}); len(list) > 0 && list[0] != nil {
+					m := list[0].(map[string]interface{})
+					enabled = m[string(MaintenanceEnabled)].(bool)
+					timeout = m[string(MaintenanceTimeout)].(int)
 				}
 			}
+
+			// While the group is between statuses the configured value is
+			// kept, so that an ongoing transition does not show up as a change.
+			status := spotinst.StringValue(beanstalkWrapper.MaintenanceStatus)
+			switch status {
+			case MaintenanceStatusActive:
+				enabled = false
+			case MaintenanceStatusAwaitUserUpdate:
+				enabled = true
+			}
+
+			value := []interface{}{
+				map[string]interface{}{
+					string(MaintenanceEnabled): enabled,
+					string(MaintenanceTimeout): timeout,
+					string(MaintenanceStatus):  status,
+				},
+			}
+			if err := resourceData.Set(string(Maintenance), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Maintenance), err)
+			}
 			return nil
 		},
 		nil,
+		nil,
+		nil,
 	)
 
 	fieldsMap[ManagedActions] = commons.NewGenericField(
@@ -10,6 +10,7 @@ const (
 	TaskType             commons.FieldName = "task_type"
 	Frequency            commons.FieldName = "frequency"
 	CronExpression       commons.FieldName = "cron_expression"
+	NextExecutions       commons.FieldName = "next_executions"
 	StartTime            commons.FieldName = "start_time"
 	ScaleTargetCapacity  commons.FieldName = "scale_target_capacity"
 	ScaleMinCapacity     commons.FieldName = "scale_min_capacity"
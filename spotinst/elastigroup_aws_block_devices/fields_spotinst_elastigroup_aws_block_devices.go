@@ -120,6 +120,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	// Both block device fields share the block device mappings of the group.
 	fieldsMap[EphemeralBlockDevice] = commons.NewGenericField(
 		commons.ElastigroupAWSBlockDevices,
 		EphemeralBlockDevice,
@@ -186,7 +187,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		nil,
-	)
+	).SetDependencies(EbsBlockDevice)
 }
 
 func hashAWSGroupEBSBlockDevice(v interface{}) int {
@@ -26,13 +26,15 @@ func SetupRancher(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(AccessKey): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Required:  true,
+						Sensitive: true,
 					},
 
 					string(SecretKey): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Required:  true,
+						Sensitive: true,
 					},
 
 					string(Version): {
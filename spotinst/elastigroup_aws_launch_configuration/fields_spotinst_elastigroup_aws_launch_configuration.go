@@ -1,7 +1,6 @@
 package elastigroup_aws_launch_configuration
 
 import (
-	"encoding/base64"
 	"fmt"
 	"regexp"
 
@@ -203,48 +202,45 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 	fieldsMap[UserData] = commons.NewGenericField(
 		commons.ElastigroupAWSLaunchConfiguration,
 		UserData,
-		&schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
-			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-				// Sometimes the EC2 API responds with the equivalent, empty SHA1 sum
-				if (old == "da39a3ee5e6b4b0d3255bfef95601890afd80709" && new == "") ||
-					(old == "" && new == "da39a3ee5e6b4b0d3255bfef95601890afd80709") {
-					return true
-				}
-				return false
-			},
-			StateFunc: Base64StateFunc,
-		},
-
+		commons.UserDataSchema(UserDataBase64),
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
-			var value = ""
-			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
-				elastigroup.Compute.LaunchSpecification.UserData != nil {
-
-				userData := elastigroup.Compute.LaunchSpecification.UserData
-				userDataValue := spotinst.StringValue(userData)
-				if userDataValue != "" {
-					if isBase64Encoded(resourceData.Get(string(UserData)).(string)) {
-						value = userDataValue
-					} else {
-						decodedUserData, _ := base64.StdEncoding.DecodeString(userDataValue)
-						value = string(decodedUserData)
-					}
-				}
+			var userData *string
+			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil {
+				userData = elastigroup.Compute.LaunchSpecification.UserData
 			}
-			if err := resourceData.Set(string(UserData), Base64StateFunc(value)); err != nil {
-				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(UserData), err)
+			return commons.FlattenUserData(resourceData, UserData, UserDataBase64, userData)
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if userData := commons.ExpandUserData(resourceData, UserData, UserDataBase64); userData != nil {
+				elastigroup.Compute.LaunchSpecification.SetUserData(userData)
 			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
-			if v, ok := resourceData.Get(string(UserData)).(string); ok && v != "" {
-				userData := spotinst.String(base64Encode(v))
+			elastigroup.Compute.LaunchSpecification.SetUserData(commons.ExpandUserData(resourceData, UserData, UserDataBase64))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[UserDataBase64] = commons.NewGenericField(
+		commons.ElastigroupAWSLaunchConfiguration,
+		UserDataBase64,
+		commons.UserDataBase64Schema(UserData),
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			// Read along with the user_data field.
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if userData := commons.ExpandUserData(resourceData, UserData, UserDataBase64); userData != nil {
 				elastigroup.Compute.LaunchSpecification.SetUserData(userData)
 			}
 			return nil
@@ -252,11 +248,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
-			var userData *string = nil
-			if v, ok := resourceData.Get(string(UserData)).(string); ok && v != "" {
-				userData = spotinst.String(base64Encode(v))
-			}
-			elastigroup.Compute.LaunchSpecification.SetUserData(userData)
+			elastigroup.Compute.LaunchSpecification.SetUserData(commons.ExpandUserData(resourceData, UserData, UserDataBase64))
 			return nil
 		},
 		nil,
@@ -276,28 +268,17 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 				}
 				return false
 			},
-			StateFunc: Base64StateFunc,
+			StateFunc: commons.Base64StateFunc,
 		},
 
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value = ""
-			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
-				elastigroup.Compute.LaunchSpecification.ShutdownScript != nil {
-
-				shutdownScript := elastigroup.Compute.LaunchSpecification.ShutdownScript
-				shutdownScriptValue := spotinst.StringValue(shutdownScript)
-				if shutdownScriptValue != "" {
-					if isBase64Encoded(resourceData.Get(string(ShutdownScript)).(string)) {
-						value = shutdownScriptValue
-					} else {
-						decodedShutdownScript, _ := base64.StdEncoding.DecodeString(shutdownScriptValue)
-						value = string(decodedShutdownScript)
-					}
-				}
+			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil {
+				value = spotinst.StringValue(elastigroup.Compute.LaunchSpecification.ShutdownScript)
 			}
-			if err := resourceData.Set(string(ShutdownScript), Base64StateFunc(value)); err != nil {
+			if err := resourceData.Set(string(ShutdownScript), value); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ShutdownScript), err)
 			}
 			return nil
@@ -306,7 +287,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(ShutdownScript)).(string); ok && v != "" {
-				shutdownScript := spotinst.String(base64Encode(v))
+				shutdownScript := spotinst.String(commons.Base64Encode(v))
 				elastigroup.Compute.LaunchSpecification.SetShutdownScript(shutdownScript)
 			}
 			return nil
@@ -316,7 +297,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup := egWrapper.GetElastigroup()
 			var shutdownScript *string = nil
 			if v, ok := resourceData.Get(string(ShutdownScript)).(string); ok && v != "" {
-				shutdownScript = spotinst.String(base64Encode(v))
+				shutdownScript = spotinst.String(commons.Base64Encode(v))
 			}
 			elastigroup.Compute.LaunchSpecification.SetShutdownScript(shutdownScript)
 			return nil
@@ -696,31 +677,6 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 
 var InstanceProfileArnRegex = regexp.MustCompile(`arn:aws:iam::\d{12}:instance-profile/?[a-zA-Z_0-9+=,.@\-_/]+`)
 
-func Base64StateFunc(v interface{}) string {
-	if isBase64Encoded(v.(string)) {
-		return v.(string)
-	} else {
-		return base64Encode(v.(string))
-	}
-}
-
-// base64Encode encodes data if the input isn't already encoded using
-// base64.StdEncoding.EncodeToString. If the input is already base64 encoded,
-// return the original input unchanged.
-func base64Encode(data string) string {
-	// Check whether the data is already Base64 encoded; don't double-encode
-	if isBase64Encoded(data) {
-		return data
-	}
-	// data has not been encoded encode and return
-	return base64.StdEncoding.EncodeToString([]byte(data))
-}
-
-func isBase64Encoded(data string) bool {
-	_, err := base64.StdEncoding.DecodeString(data)
-	return err == nil
-}
-
 func expandMetadataOptions(data interface{}) (*aws.MetadataOptions, error) {
 	metadataOptions := &aws.MetadataOptions{}
 	list := data.([]interface{})
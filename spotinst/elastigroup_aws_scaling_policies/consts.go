@@ -9,6 +9,8 @@ const (
 	ScalingDownPolicy   commons.FieldName = "scaling_down_policy"
 	ScalingTargetPolicy commons.FieldName = "scaling_target_policy"
 
+	IgnoreExternalScalingPolicies commons.FieldName = "ignore_external_scaling_policies"
+
 	PolicyName commons.FieldName = "policy_name"
 	MetricName commons.FieldName = "metric_name"
 	Namespace  commons.FieldName = "namespace"
@@ -20,7 +20,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup := egWrapper.GetElastigroup()
 			var policiesResult []interface{} = nil
 			if elastigroup.Scaling != nil && elastigroup.Scaling.Up != nil {
-				scaleUpPolicies := elastigroup.Scaling.Up
+				scaleUpPolicies := managedScalingPolicies(elastigroup.Scaling.Up, ScalingUpPolicy, resourceData)
 				policiesResult = flattenAWSGroupScalingPolicy(scaleUpPolicies)
 			}
 			if err := resourceData.Set(string(ScalingUpPolicy), policiesResult); err != nil {
@@ -66,7 +66,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup := egWrapper.GetElastigroup()
 			var policiesResult []interface{} = nil
 			if elastigroup.Scaling != nil && elastigroup.Scaling.Down != nil {
-				scaleDownPolicies := elastigroup.Scaling.Down
+				scaleDownPolicies := managedScalingPolicies(elastigroup.Scaling.Down, ScalingDownPolicy, resourceData)
 				policiesResult = flattenAWSGroupScalingPolicy(scaleDownPolicies)
 			}
 			if err := resourceData.Set(string(ScalingDownPolicy), policiesResult); err != nil {
@@ -112,7 +112,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup := egWrapper.GetElastigroup()
 			var policiesResult []interface{} = nil
 			if elastigroup.Scaling != nil && elastigroup.Scaling.Target != nil {
-				scaleTargetPolicies := elastigroup.Scaling.Target
+				scaleTargetPolicies := managedScalingPolicies(elastigroup.Scaling.Target, ScalingTargetPolicy, resourceData)
 				policiesResult = flattenAWSGroupScalingPolicy(scaleTargetPolicies)
 			}
 			if err := resourceData.Set(string(ScalingTargetPolicy), policiesResult); err != nil {
@@ -148,6 +148,49 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[IgnoreExternalScalingPolicies] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicies,
+		IgnoreExternalScalingPolicies,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		nil, nil, nil, nil,
+	)
+}
+
+// managedScalingPolicies filters out policies that are not configured on the
+// group, when these are managed externally (e.g. by spotinst_elastigroup_aws_scaling_policy).
+func managedScalingPolicies(policies []*aws.ScalingPolicy, fieldName commons.FieldName, resourceData *schema.ResourceData) []*aws.ScalingPolicy {
+	if ignore, ok := resourceData.Get(string(IgnoreExternalScalingPolicies)).(bool); !ok || !ignore {
+		return policies
+	}
+
+	names := ScalingPolicyNames(resourceData.Get(string(fieldName)))
+	result := make([]*aws.ScalingPolicy, 0, len(policies))
+	for _, policy := range policies {
+		if names[spotinst.StringValue(policy.PolicyName)] {
+			result = append(result, policy)
+		}
+	}
+	return result
+}
+
+// ScalingPolicyNames returns the names of the policies in a scaling policy set.
+func ScalingPolicyNames(data interface{}) map[string]bool {
+	names := make(map[string]bool)
+	if set, ok := data.(*schema.Set); ok {
+		for _, item := range set.List() {
+			if m, ok := item.(map[string]interface{}); ok {
+				if v, ok := m[string(PolicyName)].(string); ok && v != "" {
+					names[v] = true
+				}
+			}
+		}
+	}
+	return names
 }
 
 func baseScalingPolicySchema() *schema.Schema {
@@ -0,0 +1,43 @@
+package elastigroup_aws_scaling_policy
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+type DimensionField string
+
+const (
+	PolicyTypeUp     = "up"
+	PolicyTypeDown   = "down"
+	PolicyTypeTarget = "target"
+)
+
+const (
+	GroupID    commons.FieldName = "group_id"
+	PolicyType commons.FieldName = "policy_type"
+
+	PolicyName commons.FieldName = "policy_name"
+	MetricName commons.FieldName = "metric_name"
+	Namespace  commons.FieldName = "namespace"
+	Source     commons.FieldName = "source"
+	Statistic  commons.FieldName = "statistic"
+	Unit       commons.FieldName = "unit"
+	Cooldown   commons.FieldName = "cooldown"
+	Dimensions commons.FieldName = "dimensions"
+
+	Threshold           commons.FieldName = "threshold"
+	Adjustment          commons.FieldName = "adjustment"
+	MinTargetCapacity   commons.FieldName = "min_target_capacity"
+	MaxTargetCapacity   commons.FieldName = "max_target_capacity"
+	Operator            commons.FieldName = "operator"
+	EvaluationPeriods   commons.FieldName = "evaluation_periods"
+	Period              commons.FieldName = "period"
+	Minimum             commons.FieldName = "minimum"
+	Maximum             commons.FieldName = "maximum"
+	Target              commons.FieldName = "target"
+	ActionType          commons.FieldName = "action_type"
+	IsEnabled           commons.FieldName = "is_enabled"
+	PredictiveMode      commons.FieldName = "predictive_mode"
+	MaxCapacityPerScale commons.FieldName = "max_capacity_per_scale"
+
+	DimensionName  DimensionField = "name"
+	DimensionValue DimensionField = "value"
+)
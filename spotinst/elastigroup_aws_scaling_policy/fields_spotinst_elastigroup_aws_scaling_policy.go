@@ -0,0 +1,842 @@
+package elastigroup_aws_scaling_policy
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[GroupID] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		GroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			spWrapper := resourceObject.(*commons.ElastigroupScalingPolicyWrapper)
+			if err := resourceData.Set(string(GroupID), spotinst.StringValue(spWrapper.GroupID)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(GroupID), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			spWrapper := resourceObject.(*commons.ElastigroupScalingPolicyWrapper)
+			spWrapper.GroupID = spotinst.String(resourceData.Get(string(GroupID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[PolicyType] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		PolicyType,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				PolicyTypeUp,
+				PolicyTypeDown,
+				PolicyTypeTarget,
+			}, false),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			spWrapper := resourceObject.(*commons.ElastigroupScalingPolicyWrapper)
+			if err := resourceData.Set(string(PolicyType), spotinst.StringValue(spWrapper.PolicyType)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(PolicyType), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			spWrapper := resourceObject.(*commons.ElastigroupScalingPolicyWrapper)
+			spWrapper.PolicyType = spotinst.String(resourceData.Get(string(PolicyType)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[PolicyName] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		PolicyName,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if err := resourceData.Set(string(PolicyName), spotinst.StringValue(policy.PolicyName)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(PolicyName), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			policy.SetPolicyName(spotinst.String(resourceData.Get(string(PolicyName)).(string)))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[MetricName] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		MetricName,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if err := resourceData.Set(string(MetricName), spotinst.StringValue(policy.MetricName)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MetricName), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			policy.SetMetricName(spotinst.String(resourceData.Get(string(MetricName)).(string)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			policy.SetMetricName(spotinst.String(resourceData.Get(string(MetricName)).(string)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Namespace] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		Namespace,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if err := resourceData.Set(string(Namespace), spotinst.StringValue(policy.Namespace)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Namespace), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			policy.SetNamespace(spotinst.String(resourceData.Get(string(Namespace)).(string)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			policy.SetNamespace(spotinst.String(resourceData.Get(string(Namespace)).(string)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Source] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		Source,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if err := resourceData.Set(string(Source), spotinst.StringValue(policy.Source)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Source), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if v, ok := resourceData.Get(string(Source)).(string); ok && v != "" {
+				policy.SetSource(spotinst.String(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if v, ok := resourceData.Get(string(Source)).(string); ok && v != "" {
+				policy.SetSource(spotinst.String(v))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Statistic] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		Statistic,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "average",
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if err := resourceData.Set(string(Statistic), spotinst.StringValue(policy.Statistic)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Statistic), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			policy.SetStatistic(spotinst.String(resourceData.Get(string(Statistic)).(string)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			policy.SetStatistic(spotinst.String(resourceData.Get(string(Statistic)).(string)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Unit] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		Unit,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "percent",
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if err := resourceData.Set(string(Unit), spotinst.StringValue(policy.Unit)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Unit), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			policy.SetUnit(spotinst.String(resourceData.Get(string(Unit)).(string)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			policy.SetUnit(spotinst.String(resourceData.Get(string(Unit)).(string)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Cooldown] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		Cooldown,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  300,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if err := resourceData.Set(string(Cooldown), spotinst.IntValue(policy.Cooldown)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Cooldown), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if v, ok := resourceData.Get(string(Cooldown)).(int); ok && v > 0 {
+				policy.SetCooldown(spotinst.Int(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if v, ok := resourceData.Get(string(Cooldown)).(int); ok && v > 0 {
+				policy.SetCooldown(spotinst.Int(v))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Dimensions] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		Dimensions,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(DimensionName): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(DimensionValue): {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if err := resourceData.Set(string(Dimensions), flattenDimensions(policy.Dimensions)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Dimensions), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if v, ok := resourceData.GetOk(string(Dimensions)); ok {
+				if dimensions := expandDimensions(v); len(dimensions) > 0 {
+					policy.SetDimensions(dimensions)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			var value []*aws.Dimension = nil
+			if v, ok := resourceData.GetOk(string(Dimensions)); ok {
+				if dimensions := expandDimensions(v); len(dimensions) > 0 {
+					value = dimensions
+				}
+			}
+			policy.SetDimensions(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Threshold] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		Threshold,
+		&schema.Schema{
+			Type:     schema.TypeFloat,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if err := resourceData.Set(string(Threshold), spotinst.Float64Value(policy.Threshold)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Threshold), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if v, ok := resourceData.Get(string(Threshold)).(float64); ok && v > 0 {
+				policy.SetThreshold(spotinst.Float64(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			var value *float64 = nil
+			if v, ok := resourceData.Get(string(Threshold)).(float64); ok && v > 0 {
+				value = spotinst.Float64(v)
+			}
+			policy.SetThreshold(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Operator] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		Operator,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if err := resourceData.Set(string(Operator), spotinst.StringValue(policy.Operator)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Operator), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if v, ok := resourceData.Get(string(Operator)).(string); ok && v != "" {
+				policy.SetOperator(spotinst.String(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if v, ok := resourceData.Get(string(Operator)).(string); ok && v != "" {
+				policy.SetOperator(spotinst.String(v))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[EvaluationPeriods] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		EvaluationPeriods,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if err := resourceData.Set(string(EvaluationPeriods), spotinst.IntValue(policy.EvaluationPeriods)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(EvaluationPeriods), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if v, ok := resourceData.Get(string(EvaluationPeriods)).(int); ok && v > 0 {
+				policy.SetEvaluationPeriods(spotinst.Int(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if v, ok := resourceData.Get(string(EvaluationPeriods)).(int); ok && v > 0 {
+				policy.SetEvaluationPeriods(spotinst.Int(v))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Period] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		Period,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if err := resourceData.Set(string(Period), spotinst.IntValue(policy.Period)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Period), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if v, ok := resourceData.Get(string(Period)).(int); ok && v > 0 {
+				policy.SetPeriod(spotinst.Int(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if v, ok := resourceData.Get(string(Period)).(int); ok && v > 0 {
+				policy.SetPeriod(spotinst.Int(v))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[IsEnabled] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		IsEnabled,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			// Target policies are always enabled and do not report the flag back.
+			if policy.IsEnabled != nil {
+				if err := resourceData.Set(string(IsEnabled), spotinst.BoolValue(policy.IsEnabled)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(IsEnabled), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			policy.SetIsEnabled(spotinst.Bool(resourceData.Get(string(IsEnabled)).(bool)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			policy.SetIsEnabled(spotinst.Bool(resourceData.Get(string(IsEnabled)).(bool)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[ActionType] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		ActionType,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			var value *string = nil
+			if policy.Action != nil {
+				value = policy.Action.Type
+			}
+			if err := resourceData.Set(string(ActionType), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ActionType), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			applyAction(resourceObject.(*commons.ElastigroupScalingPolicyWrapper), resourceData)
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			applyAction(resourceObject.(*commons.ElastigroupScalingPolicyWrapper), resourceData)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Adjustment] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		Adjustment,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			var value *string = nil
+			if policy.Action != nil {
+				value = policy.Action.Adjustment
+			}
+			if err := resourceData.Set(string(Adjustment), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Adjustment), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			applyAction(resourceObject.(*commons.ElastigroupScalingPolicyWrapper), resourceData)
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			applyAction(resourceObject.(*commons.ElastigroupScalingPolicyWrapper), resourceData)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[MinTargetCapacity] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		MinTargetCapacity,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			var value *string = nil
+			if policy.Action != nil {
+				value = policy.Action.MinTargetCapacity
+			}
+			if err := resourceData.Set(string(MinTargetCapacity), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MinTargetCapacity), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			applyAction(resourceObject.(*commons.ElastigroupScalingPolicyWrapper), resourceData)
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			applyAction(resourceObject.(*commons.ElastigroupScalingPolicyWrapper), resourceData)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[MaxTargetCapacity] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		MaxTargetCapacity,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			var value *string = nil
+			if policy.Action != nil {
+				value = policy.Action.MaxTargetCapacity
+			}
+			if err := resourceData.Set(string(MaxTargetCapacity), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MaxTargetCapacity), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			applyAction(resourceObject.(*commons.ElastigroupScalingPolicyWrapper), resourceData)
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			applyAction(resourceObject.(*commons.ElastigroupScalingPolicyWrapper), resourceData)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Minimum] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		Minimum,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			var value *string = nil
+			if policy.Action != nil {
+				value = policy.Action.Minimum
+			}
+			if err := resourceData.Set(string(Minimum), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Minimum), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			applyAction(resourceObject.(*commons.ElastigroupScalingPolicyWrapper), resourceData)
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			applyAction(resourceObject.(*commons.ElastigroupScalingPolicyWrapper), resourceData)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Maximum] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		Maximum,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			var value *string = nil
+			if policy.Action != nil {
+				value = policy.Action.Maximum
+			}
+			if err := resourceData.Set(string(Maximum), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Maximum), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			applyAction(resourceObject.(*commons.ElastigroupScalingPolicyWrapper), resourceData)
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			applyAction(resourceObject.(*commons.ElastigroupScalingPolicyWrapper), resourceData)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Target] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		Target,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			value := ""
+			if policy.Action != nil && policy.Action.Target != nil {
+				value = spotinst.StringValue(policy.Action.Target)
+			} else if policy.Target != nil {
+				value = strconv.FormatFloat(spotinst.Float64Value(policy.Target), 'f', -1, 64)
+			}
+			if err := resourceData.Set(string(Target), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Target), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return applyTarget(resourceObject.(*commons.ElastigroupScalingPolicyWrapper), resourceData)
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return applyTarget(resourceObject.(*commons.ElastigroupScalingPolicyWrapper), resourceData)
+		},
+		nil,
+	)
+
+	fieldsMap[PredictiveMode] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		PredictiveMode,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			var value *string = nil
+			if policy.Predictive != nil {
+				value = policy.Predictive.Mode
+			}
+			if err := resourceData.Set(string(PredictiveMode), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(PredictiveMode), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if v, ok := resourceData.Get(string(PredictiveMode)).(string); ok && v != "" {
+				policy.SetPredictive(&aws.Predictive{Mode: spotinst.String(v)})
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			var value *aws.Predictive = nil
+			if v, ok := resourceData.Get(string(PredictiveMode)).(string); ok && v != "" {
+				value = &aws.Predictive{Mode: spotinst.String(v)}
+			}
+			policy.SetPredictive(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[MaxCapacityPerScale] = commons.NewGenericField(
+		commons.ElastigroupAWSScalingPolicy,
+		MaxCapacityPerScale,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if err := resourceData.Set(string(MaxCapacityPerScale), spotinst.StringValue(policy.MaxCapacityPerScale)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MaxCapacityPerScale), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			if v, ok := resourceData.Get(string(MaxCapacityPerScale)).(string); ok && v != "" {
+				policy.SetMaxCapacityPerScale(spotinst.String(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.ElastigroupScalingPolicyWrapper).GetScalingPolicy()
+			var value *string = nil
+			if v, ok := resourceData.Get(string(MaxCapacityPerScale)).(string); ok && v != "" {
+				value = spotinst.String(v)
+			}
+			policy.SetMaxCapacityPerScale(value)
+			return nil
+		},
+		nil,
+	)
+}
+
+// applyTarget sets the numeric target of a target tracking policy. The target
+// of a step policy belongs to its action, see applyAction.
+func applyTarget(spWrapper *commons.ElastigroupScalingPolicyWrapper, resourceData *schema.ResourceData) error {
+	if resourceData.Get(string(PolicyType)).(string) != PolicyTypeTarget {
+		applyAction(spWrapper, resourceData)
+		return nil
+	}
+
+	v, _ := resourceData.Get(string(Target)).(string)
+	if v == "" {
+		return fmt.Errorf("field [%v] is required for %q policies", string(Target), PolicyTypeTarget)
+	}
+	target, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return fmt.Errorf("field [%v] must be a number for %q policies: %v", string(Target), PolicyTypeTarget, err)
+	}
+	spWrapper.GetScalingPolicy().SetTarget(spotinst.Float64(target))
+	return nil
+}
+
+// applyAction rebuilds the policy action as a whole from the action fields,
+// since they are only meaningful together with an `action_type`.
+func applyAction(spWrapper *commons.ElastigroupScalingPolicyWrapper, resourceData *schema.ResourceData) {
+	policy := spWrapper.GetScalingPolicy()
+
+	actionType, _ := resourceData.Get(string(ActionType)).(string)
+	if actionType == "" || resourceData.Get(string(PolicyType)).(string) == PolicyTypeTarget {
+		policy.SetAction(nil)
+		return
+	}
+
+	action := &aws.Action{}
+	action.SetType(spotinst.String(actionType))
+
+	if v, ok := resourceData.Get(string(Adjustment)).(string); ok && v != "" {
+		action.SetAdjustment(spotinst.String(v))
+	}
+
+	if v, ok := resourceData.Get(string(MinTargetCapacity)).(string); ok && v != "" {
+		action.SetMinTargetCapacity(spotinst.String(v))
+	}
+
+	if v, ok := resourceData.Get(string(MaxTargetCapacity)).(string); ok && v != "" {
+		action.SetMaxTargetCapacity(spotinst.String(v))
+	}
+
+	if v, ok := resourceData.Get(string(Minimum)).(string); ok && v != "" {
+		action.SetMinimum(spotinst.String(v))
+	}
+
+	if v, ok := resourceData.Get(string(Maximum)).(string); ok && v != "" {
+		action.SetMaximum(spotinst.String(v))
+	}
+
+	if v, ok := resourceData.Get(string(Target)).(string); ok && v != "" {
+		action.SetTarget(spotinst.String(v))
+	}
+
+	policy.SetAction(action)
+}
+
+func expandDimensions(data interface{}) []*aws.Dimension {
+	list := data.([]interface{})
+	dimensions := make([]*aws.Dimension, 0, len(list))
+	for _, v := range list {
+		attr, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := attr[string(DimensionName)].(string)
+		value, _ := attr[string(DimensionValue)].(string)
+		if name == "" {
+			continue
+		}
+		dimensions = append(dimensions, &aws.Dimension{
+			Name:  spotinst.String(name),
+			Value: spotinst.String(value),
+		})
+	}
+	return dimensions
+}
+
+func flattenDimensions(dimensions []*aws.Dimension) []interface{} {
+	result := make([]interface{}, 0, len(dimensions))
+	for _, dimension := range dimensions {
+		m := make(map[string]interface{})
+		m[string(DimensionName)] = spotinst.StringValue(dimension.Name)
+		m[string(DimensionValue)] = spotinst.StringValue(dimension.Value)
+		result = append(result, m)
+	}
+	return result
+}
@@ -8,12 +8,20 @@ const (
 
 const TaskTypeStatefulUpdateCapacity = "statefulUpdateCapacity"
 
+// StartTimeFormat is the ISO 8601 UTC format the API accepts for `start_time`.
+const StartTimeFormat = "2006-01-02T15:04:05Z"
+
+// LocalStartTimeFormat is the wall clock format accepted for `start_time`
+// when a `timezone` is set on the standalone resource.
+const LocalStartTimeFormat = "2006-01-02T15:04:05"
+
 const (
 	ScheduledTask        commons.FieldName = "scheduled_task"
 	IsEnabled            commons.FieldName = "is_enabled"
 	TaskType             commons.FieldName = "task_type"
 	Frequency            commons.FieldName = "frequency"
 	CronExpression       commons.FieldName = "cron_expression"
+	NextExecutions       commons.FieldName = "next_executions"
 	StartTime            commons.FieldName = "start_time"
 	ScaleTargetCapacity  commons.FieldName = "scale_target_capacity"
 	ScaleMinCapacity     commons.FieldName = "scale_min_capacity"
@@ -26,3 +34,8 @@ const (
 	Adjustment           commons.FieldName = "adjustment"
 	AdjustmentPercentage commons.FieldName = "adjustment_percentage"
 )
+
+const (
+	GroupID  commons.FieldName = "group_id"
+	Timezone commons.FieldName = "timezone"
+)
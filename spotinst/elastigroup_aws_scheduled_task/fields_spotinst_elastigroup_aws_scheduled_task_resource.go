@@ -0,0 +1,458 @@
+package elastigroup_aws_scheduled_task
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// SetupResource sets up the fields of the standalone `spotinst_elastigroup_aws_scheduled_task`
+// resource, which manages a single task of an existing group.
+func SetupResource(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[GroupID] = commons.NewGenericField(
+		commons.ElastigroupAWSStandaloneTask,
+		GroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stWrapper := resourceObject.(*commons.ElastigroupScheduledTaskWrapper)
+			if err := resourceData.Set(string(GroupID), spotinst.StringValue(stWrapper.GroupID)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(GroupID), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stWrapper := resourceObject.(*commons.ElastigroupScheduledTaskWrapper)
+			stWrapper.GroupID = spotinst.String(resourceData.Get(string(GroupID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[TaskType] = commons.NewGenericField(
+		commons.ElastigroupAWSStandaloneTask,
+		TaskType,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"scale",
+				"backup_ami",
+				"roll",
+				"scaleUp",
+				"percentageScaleUp",
+				"scaleDown",
+				"percentageScaleDown",
+				TaskTypeStatefulUpdateCapacity,
+			}, false),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			if err := resourceData.Set(string(TaskType), spotinst.StringValue(task.Type)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TaskType), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			task.SetType(spotinst.String(resourceData.Get(string(TaskType)).(string)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			task.SetType(spotinst.String(resourceData.Get(string(TaskType)).(string)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[IsEnabled] = commons.NewGenericField(
+		commons.ElastigroupAWSStandaloneTask,
+		IsEnabled,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			if err := resourceData.Set(string(IsEnabled), spotinst.BoolValue(task.IsEnabled)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(IsEnabled), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			task.SetIsEnabled(spotinst.Bool(resourceData.Get(string(IsEnabled)).(bool)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			task.SetIsEnabled(spotinst.Bool(resourceData.Get(string(IsEnabled)).(bool)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Frequency] = commons.NewGenericField(
+		commons.ElastigroupAWSStandaloneTask,
+		Frequency,
+		&schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{string(CronExpression)},
+			ValidateFunc: validation.StringInSlice([]string{
+				"hourly",
+				"daily",
+				"weekly",
+				"continuous",
+			}, false),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			if err := resourceData.Set(string(Frequency), spotinst.StringValue(task.Frequency)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Frequency), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			if v, ok := resourceData.GetOk(string(Frequency)); ok {
+				task.SetFrequency(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			var value *string = nil
+			if v, ok := resourceData.GetOk(string(Frequency)); ok {
+				value = spotinst.String(v.(string))
+			}
+			task.SetFrequency(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[CronExpression] = commons.NewGenericField(
+		commons.ElastigroupAWSStandaloneTask,
+		CronExpression,
+		&schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{string(Frequency)},
+			ValidateFunc:  commons.ValidateCronExpression,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			if err := resourceData.Set(string(CronExpression), spotinst.StringValue(task.CronExpression)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(CronExpression), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			if v, ok := resourceData.GetOk(string(CronExpression)); ok {
+				task.SetCronExpression(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			var value *string = nil
+			if v, ok := resourceData.GetOk(string(CronExpression)); ok {
+				value = spotinst.String(v.(string))
+			}
+			task.SetCronExpression(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[NextExecutions] = commons.NewGenericField(
+		commons.ElastigroupAWSStandaloneTask,
+		NextExecutions,
+		commons.NextExecutionsSchema(),
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			value := commons.CronNextExecutions(spotinst.StringValue(task.CronExpression))
+			if err := resourceData.Set(string(NextExecutions), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(NextExecutions), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[StartTime] = commons.NewGenericField(
+		commons.ElastigroupAWSStandaloneTask,
+		StartTime,
+		&schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validateStartTime,
+			DiffSuppressFunc: suppressEquivalentStartTime,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			value := readStartTime(spotinst.StringValue(task.StartTime), resourceData)
+			if err := resourceData.Set(string(StartTime), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(StartTime), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return applyStartTime(resourceObject.(*commons.ElastigroupScheduledTaskWrapper), resourceData)
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return applyStartTime(resourceObject.(*commons.ElastigroupScheduledTaskWrapper), resourceData)
+		},
+		nil,
+	)
+
+	fieldsMap[Timezone] = commons.NewGenericField(
+		commons.ElastigroupAWSStandaloneTask,
+		Timezone,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateTimezone,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return applyStartTime(resourceObject.(*commons.ElastigroupScheduledTaskWrapper), resourceData)
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return applyStartTime(resourceObject.(*commons.ElastigroupScheduledTaskWrapper), resourceData)
+		},
+		nil,
+	)
+
+	setupIntField(fieldsMap, ScaleTargetCapacity,
+		func(t *aws.Task) *int { return t.ScaleTargetCapacity },
+		func(t *aws.Task, v *int) { t.SetScaleTargetCapacity(v) })
+
+	setupIntField(fieldsMap, ScaleMinCapacity,
+		func(t *aws.Task) *int { return t.ScaleMinCapacity },
+		func(t *aws.Task, v *int) { t.SetScaleMinCapacity(v) })
+
+	setupIntField(fieldsMap, ScaleMaxCapacity,
+		func(t *aws.Task) *int { return t.ScaleMaxCapacity },
+		func(t *aws.Task, v *int) { t.SetScaleMaxCapacity(v) })
+
+	setupIntField(fieldsMap, BatchSizePercentage,
+		func(t *aws.Task) *int { return t.BatchSizePercentage },
+		func(t *aws.Task, v *int) { t.SetBatchSizePercentage(v) })
+
+	setupIntField(fieldsMap, GracePeriod,
+		func(t *aws.Task) *int { return t.GracePeriod },
+		func(t *aws.Task, v *int) { t.SetGracePeriod(v) })
+
+	setupIntField(fieldsMap, TargetCapacity,
+		func(t *aws.Task) *int { return t.TargetCapacity },
+		func(t *aws.Task, v *int) { t.SetTargetCapacity(v) })
+
+	setupIntField(fieldsMap, MinCapacity,
+		func(t *aws.Task) *int { return t.MinCapacity },
+		func(t *aws.Task, v *int) { t.SetMinCapacity(v) })
+
+	setupIntField(fieldsMap, MaxCapacity,
+		func(t *aws.Task) *int { return t.MaxCapacity },
+		func(t *aws.Task, v *int) { t.SetMaxCapacity(v) })
+
+	setupIntField(fieldsMap, Adjustment,
+		func(t *aws.Task) *int { return t.Adjustment },
+		func(t *aws.Task, v *int) { t.SetAdjustment(v) })
+
+	setupIntField(fieldsMap, AdjustmentPercentage,
+		func(t *aws.Task) *int { return t.AdjustmentPercentage },
+		func(t *aws.Task, v *int) { t.SetAdjustmentPercentage(v) })
+}
+
+// setupIntField sets up an optional numeric task attribute. As in the group's
+// `scheduled_task` block, the value is kept as a string so that `0` can be told
+// apart from an unset value.
+func setupIntField(
+	fieldsMap map[commons.FieldName]*commons.GenericField,
+	fieldName commons.FieldName,
+	get func(*aws.Task) *int,
+	set func(*aws.Task, *int)) {
+
+	apply := func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+		task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+		var value *int = nil
+		if v, ok := resourceData.Get(string(fieldName)).(string); ok && v != "" {
+			if intVal, err := strconv.Atoi(v); err != nil {
+				return fmt.Errorf("field [%v] must be an integer: %v", string(fieldName), err)
+			} else {
+				value = spotinst.Int(intVal)
+			}
+		}
+		// Only clear values that are set, so that new tasks do not carry nulls.
+		if value != nil || get(task) != nil {
+			set(task, value)
+		}
+		return nil
+	}
+
+	fieldsMap[fieldName] = commons.NewGenericField(
+		commons.ElastigroupAWSStandaloneTask,
+		fieldName,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupScheduledTaskWrapper).GetScheduledTask()
+			value := ""
+			if v := get(task); v != nil {
+				value = strconv.Itoa(spotinst.IntValue(v))
+			}
+			if err := resourceData.Set(string(fieldName), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(fieldName), err)
+			}
+			return nil
+		},
+		apply,
+		apply,
+		nil,
+	)
+}
+
+// TaskKey identifies a task within the task list of its group. Tasks have no ID
+// of their own, so the key is derived from the attributes that define when the
+// task runs.
+func TaskKey(task *aws.Task) string {
+	startTime := spotinst.StringValue(task.StartTime)
+	if t, err := time.Parse(time.RFC3339, startTime); err == nil {
+		startTime = t.UTC().Format(StartTimeFormat)
+	}
+
+	var buf strings.Builder
+	buf.WriteString(fmt.Sprintf("%s-", spotinst.StringValue(task.Type)))
+	buf.WriteString(fmt.Sprintf("%s-", spotinst.StringValue(task.CronExpression)))
+	buf.WriteString(fmt.Sprintf("%s-", spotinst.StringValue(task.Frequency)))
+	buf.WriteString(fmt.Sprintf("%s-", startTime))
+	return hashcode.Strings([]string{buf.String()})
+}
+
+func applyStartTime(stWrapper *commons.ElastigroupScheduledTaskWrapper, resourceData *schema.ResourceData) error {
+	task := stWrapper.GetScheduledTask()
+	var value *string = nil
+	if v, ok := resourceData.GetOk(string(StartTime)); ok {
+		startTime, err := normalizeStartTime(v.(string), resourceData.Get(string(Timezone)).(string))
+		if err != nil {
+			return err
+		}
+		value = spotinst.String(startTime)
+	}
+	if value != nil || task.StartTime != nil {
+		task.SetStartTime(value)
+	}
+	return nil
+}
+
+// normalizeStartTime converts a `start_time` to the UTC format the API expects.
+// Values with an explicit offset are converted as is; wall clock values are
+// interpreted in `timezone`, or in UTC when no timezone is set.
+func normalizeStartTime(value, timezone string) (string, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC().Format(StartTimeFormat), nil
+	}
+
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(timezone); err != nil {
+			return "", fmt.Errorf("invalid timezone %q: %v", timezone, err)
+		}
+	}
+	t, err := time.ParseInLocation(LocalStartTimeFormat, value, loc)
+	if err != nil {
+		return "", fmt.Errorf("invalid start time %q, expected ISO 8601 format (%s or %s)",
+			value, time.RFC3339, LocalStartTimeFormat)
+	}
+	return t.UTC().Format(StartTimeFormat), nil
+}
+
+// readStartTime keeps the configured representation of the start time as long
+// as it refers to the same instant as the one reported by the API. Otherwise
+// the API value is shown in the configured timezone.
+func readStartTime(apiValue string, resourceData *schema.ResourceData) string {
+	if apiValue == "" {
+		return ""
+	}
+
+	timezone := resourceData.Get(string(Timezone)).(string)
+	current := resourceData.Get(string(StartTime)).(string)
+	apiNormalized, err := normalizeStartTime(apiValue, "")
+	if err != nil {
+		return apiValue
+	}
+	if current != "" {
+		if normalized, err := normalizeStartTime(current, timezone); err == nil && normalized == apiNormalized {
+			return current
+		}
+	}
+	if timezone != "" {
+		if loc, err := time.LoadLocation(timezone); err == nil {
+			t, _ := time.Parse(StartTimeFormat, apiNormalized)
+			return t.In(loc).Format(LocalStartTimeFormat)
+		}
+	}
+	return apiValue
+}
+
+func suppressEquivalentStartTime(k, old, new string, d *schema.ResourceData) bool {
+	timezone := d.Get(string(Timezone)).(string)
+	oldNormalized, err := normalizeStartTime(old, timezone)
+	if err != nil {
+		return false
+	}
+	newNormalized, err := normalizeStartTime(new, timezone)
+	if err != nil {
+		return false
+	}
+	return oldNormalized == newNormalized
+}
+
+func validateStartTime(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return
+	}
+	if _, err := time.Parse(LocalStartTimeFormat, value); err != nil {
+		es = append(es, fmt.Errorf("%s: invalid start time %q, expected ISO 8601 format (%s or %s)",
+			k, value, time.RFC3339, LocalStartTimeFormat))
+	}
+	return
+}
+
+func validateTimezone(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+	if _, err := time.LoadLocation(value); err != nil {
+		es = append(es, fmt.Errorf("%s: invalid IANA timezone %q: %v", k, value, err))
+	}
+	return
+}
@@ -0,0 +1,20 @@
+package elastigroup_aws_stateful_instance_state
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	ElastigroupID      commons.FieldName = "elastigroup_id"
+	StatefulInstanceID commons.FieldName = "stateful_instance_id"
+	State              commons.FieldName = "state"
+	Timeout            commons.FieldName = "timeout"
+
+	// Computed fields
+	Status     commons.FieldName = "status"
+	InstanceID commons.FieldName = "instance_id"
+	PrivateIP  commons.FieldName = "private_ip"
+)
+
+const (
+	StateRunning = commons.InstanceStateRunning
+	StatePaused  = commons.InstanceStatePaused
+)
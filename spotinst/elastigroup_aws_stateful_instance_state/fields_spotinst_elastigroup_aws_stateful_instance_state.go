@@ -0,0 +1,187 @@
+package elastigroup_aws_stateful_instance_state
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[ElastigroupID] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstanceState,
+		ElastigroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ElastigroupAWSStatefulInstanceStateWrapper)
+			state := stateWrapper.GetElastigroupAWSStatefulInstanceState()
+			if state.ElastigroupID != nil {
+				if err := resourceData.Set(string(ElastigroupID), spotinst.StringValue(state.ElastigroupID)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ElastigroupID), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ElastigroupAWSStatefulInstanceStateWrapper)
+			state := stateWrapper.GetElastigroupAWSStatefulInstanceState()
+			state.ElastigroupID = spotinst.String(resourceData.Get(string(ElastigroupID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[StatefulInstanceID] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstanceState,
+		StatefulInstanceID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ElastigroupAWSStatefulInstanceStateWrapper)
+			state := stateWrapper.GetElastigroupAWSStatefulInstanceState()
+			if state.StatefulInstanceID != nil {
+				if err := resourceData.Set(string(StatefulInstanceID), spotinst.StringValue(state.StatefulInstanceID)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(StatefulInstanceID), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ElastigroupAWSStatefulInstanceStateWrapper)
+			state := stateWrapper.GetElastigroupAWSStatefulInstanceState()
+			state.StatefulInstanceID = spotinst.String(resourceData.Get(string(StatefulInstanceID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[State] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstanceState,
+		State,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{StateRunning, StatePaused}, false),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ElastigroupAWSStatefulInstanceStateWrapper)
+			state := stateWrapper.GetElastigroupAWSStatefulInstanceState()
+			if state.State != nil {
+				if err := resourceData.Set(string(State), spotinst.StringValue(state.State)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(State), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ElastigroupAWSStatefulInstanceStateWrapper)
+			state := stateWrapper.GetElastigroupAWSStatefulInstanceState()
+			state.State = spotinst.String(resourceData.Get(string(State)).(string))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ElastigroupAWSStatefulInstanceStateWrapper)
+			state := stateWrapper.GetElastigroupAWSStatefulInstanceState()
+			state.State = spotinst.String(resourceData.Get(string(State)).(string))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Timeout] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstanceState,
+		Timeout,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      600,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ElastigroupAWSStatefulInstanceStateWrapper)
+			state := stateWrapper.GetElastigroupAWSStatefulInstanceState()
+			state.Timeout = spotinst.Int(resourceData.Get(string(Timeout)).(int))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ElastigroupAWSStatefulInstanceStateWrapper)
+			state := stateWrapper.GetElastigroupAWSStatefulInstanceState()
+			state.Timeout = spotinst.Int(resourceData.Get(string(Timeout)).(int))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Status] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstanceState,
+		Status,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ElastigroupAWSStatefulInstanceStateWrapper)
+			state := stateWrapper.GetElastigroupAWSStatefulInstanceState()
+			if err := resourceData.Set(string(Status), spotinst.StringValue(state.Status)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Status), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[InstanceID] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstanceState,
+		InstanceID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ElastigroupAWSStatefulInstanceStateWrapper)
+			state := stateWrapper.GetElastigroupAWSStatefulInstanceState()
+			if err := resourceData.Set(string(InstanceID), spotinst.StringValue(state.InstanceID)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(InstanceID), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[PrivateIP] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstanceState,
+		PrivateIP,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			stateWrapper := resourceObject.(*commons.ElastigroupAWSStatefulInstanceStateWrapper)
+			state := stateWrapper.GetElastigroupAWSStatefulInstanceState()
+			if err := resourceData.Set(string(PrivateIP), spotinst.StringValue(state.PrivateIP)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(PrivateIP), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+}
@@ -8,6 +8,7 @@ const (
 
 const (
 	UserData                 commons.FieldName = "user_data"
+	UserDataBase64           commons.FieldName = "user_data_base64"
 	ShutdownScript           commons.FieldName = "shutdown_script"
 	CustomData               commons.FieldName = "custom_data"
 	ManagedServiceIdentities commons.FieldName = "managed_service_identities"
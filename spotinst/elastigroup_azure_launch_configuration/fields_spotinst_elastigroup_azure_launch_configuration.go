@@ -1,7 +1,6 @@
 package elastigroup_azure_launch_configuration
 
 import (
-	"encoding/base64"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -14,53 +13,49 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 	fieldsMap[UserData] = commons.NewGenericField(
 		commons.ElastigroupAzureLaunchConfiguration,
 		UserData,
-		&schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
-			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-				// check to make sure nil SHA isn't being passed from somewhere upstream
-				if (old == "da39a3ee5e6b4b0d3255bfef95601890afd80709" && new == "") ||
-					(old == "" && new == "da39a3ee5e6b4b0d3255bfef95601890afd80709") {
-					return true
-				}
-				return false
-			},
-			StateFunc: Base64StateFunc,
-		},
+		commons.UserDataSchema(UserDataBase64),
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
 			elastigroup := egWrapper.GetElastigroup()
-			var value = ""
-			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
-				elastigroup.Compute.LaunchSpecification.UserData != nil {
-
-				userData := elastigroup.Compute.LaunchSpecification.UserData
-				userDataValue := spotinst.StringValue(userData)
-				if userDataValue != "" {
-					if isBase64Encoded(resourceData.Get(string(UserData)).(string)) {
-						value = userDataValue
-					} else {
-						decodedUserData, _ := base64.StdEncoding.DecodeString(userDataValue)
-						value = string(decodedUserData)
-					}
-				}
+			var userData *string
+			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil {
+				userData = elastigroup.Compute.LaunchSpecification.UserData
 			}
-			if err := resourceData.Set(string(UserData), Base64StateFunc(value)); err != nil {
-				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(UserData), err)
+			return commons.FlattenUserData(resourceData, UserData, UserDataBase64, userData)
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if userData := commons.ExpandUserData(resourceData, UserData, UserDataBase64); userData != nil {
+				elastigroup.Compute.LaunchSpecification.SetUserData(userData)
 			}
 			return nil
 		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			err := fmt.Errorf(string(commons.FieldUpdateNotAllowedPattern), string(UserData))
+			return err
+		},
+		nil,
+	)
+
+	fieldsMap[UserDataBase64] = commons.NewGenericField(
+		commons.ElastigroupAzureLaunchConfiguration,
+		UserDataBase64,
+		commons.UserDataBase64Schema(UserData),
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			// Read along with the user_data field.
+			return nil
+		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
 			elastigroup := egWrapper.GetElastigroup()
-			if v, ok := resourceData.Get(string(UserData)).(string); ok && v != "" {
-				userData := spotinst.String(base64Encode(v))
+			if userData := commons.ExpandUserData(resourceData, UserData, UserDataBase64); userData != nil {
 				elastigroup.Compute.LaunchSpecification.SetUserData(userData)
 			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
-			err := fmt.Errorf(string(commons.FieldUpdateNotAllowedPattern), string(UserData))
+			err := fmt.Errorf(string(commons.FieldUpdateNotAllowedPattern), string(UserDataBase64))
 			return err
 		},
 		nil,
@@ -80,23 +75,16 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 				}
 				return false
 			},
-			StateFunc: Base64StateFunc,
+			StateFunc: commons.Base64StateFunc,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value = ""
-			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
-				elastigroup.Compute.LaunchSpecification.ShutdownScript != nil {
-
-				s := elastigroup.Compute.LaunchSpecification.ShutdownScript
-				scriptVal := spotinst.StringValue(s)
-				if scriptVal != "" {
-					decodedScript, _ := base64.StdEncoding.DecodeString(scriptVal)
-					value = string(decodedScript)
-				}
+			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil {
+				value = spotinst.StringValue(elastigroup.Compute.LaunchSpecification.ShutdownScript)
 			}
-			if err := resourceData.Set(string(ShutdownScript), Base64StateFunc(value)); err != nil {
+			if err := resourceData.Set(string(ShutdownScript), value); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ShutdownScript), err)
 			}
 			return nil
@@ -105,7 +93,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(ShutdownScript)).(string); ok && v != "" {
-				s := spotinst.String(base64Encode(v))
+				s := spotinst.String(commons.Base64Encode(v))
 				elastigroup.Compute.LaunchSpecification.SetShutdownScript(s)
 			}
 			return nil
@@ -115,7 +103,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup := egWrapper.GetElastigroup()
 			var shutdownScript *string = nil
 			if v, ok := resourceData.Get(string(ShutdownScript)).(string); ok && v != "" {
-				shutdownScript = spotinst.String(base64Encode(v))
+				shutdownScript = spotinst.String(commons.Base64Encode(v))
 			}
 			elastigroup.Compute.LaunchSpecification.SetShutdownScript(shutdownScript)
 			return nil
@@ -189,7 +177,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 				}
 				return false
 			},
-			StateFunc: Base64StateFunc,
+			StateFunc: commons.Base64StateFunc,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			return nil
@@ -198,7 +186,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupAzureWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(CustomData)).(string); ok && v != "" {
-				customData := spotinst.String(base64Encode(v))
+				customData := spotinst.String(commons.Base64Encode(v))
 				elastigroup.Compute.LaunchSpecification.SetCustomData(customData)
 			}
 			return nil
@@ -211,31 +199,6 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 	)
 }
 
-func Base64StateFunc(v interface{}) string {
-	if isBase64Encoded(v.(string)) {
-		return v.(string)
-	} else {
-		return base64Encode(v.(string))
-	}
-}
-
-// base64Encode encodes data if the input isn't already encoded using
-// base64.StdEncoding.EncodeToString. If the input is already base64 encoded,
-// return the original input unchanged.
-func base64Encode(data string) string {
-	// Check whether the data is already Base64 encoded; don't double-encode
-	if isBase64Encoded(data) {
-		return data
-	}
-	// data has not been encoded encode and return
-	return base64.StdEncoding.EncodeToString([]byte(data))
-}
-
-func isBase64Encoded(data string) bool {
-	_, err := base64.StdEncoding.DecodeString(data)
-	return err == nil
-}
-
 func expandAzureGroupManagedServiceIdentities(data interface{}) ([]*azure.ManagedServiceIdentity, error) {
 	list := data.(*schema.Set).List()
 	services := make([]*azure.ManagedServiceIdentity, 0, len(list))
@@ -6,6 +6,7 @@ const (
 	ScheduledTask        commons.FieldName = "scheduled_task"
 	IsEnabled            commons.FieldName = "is_enabled"
 	CronExpression       commons.FieldName = "cron_expression"
+	NextExecutions       commons.FieldName = "next_executions"
 	TaskType             commons.FieldName = "task_type"
 	ScaleTargetCapacity  commons.FieldName = "scale_target_capacity"
 	ScaleMinCapacity     commons.FieldName = "scale_min_capacity"
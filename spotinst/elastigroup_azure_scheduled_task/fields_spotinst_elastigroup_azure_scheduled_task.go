@@ -31,10 +31,13 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(CronExpression): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: commons.ValidateCronExpression,
 					},
 
+					string(NextExecutions): commons.NextExecutionsSchema(),
+
 					string(ScaleTargetCapacity): {
 						Type:     schema.TypeString,
 						Optional: true,
@@ -111,6 +114,7 @@ func flattenAzureGroupScheduledTasks(tasks []*azure.ScheduledTask) []interface{}
 		m[string(IsEnabled)] = spotinst.BoolValue(t.IsEnabled)
 		m[string(TaskType)] = spotinst.StringValue(t.TaskType)
 		m[string(CronExpression)] = spotinst.StringValue(t.CronExpression)
+		m[string(NextExecutions)] = commons.CronNextExecutions(spotinst.StringValue(t.CronExpression))
 
 		if t.ScaleTargetCapacity != nil {
 			m[string(ScaleTargetCapacity)] = strconv.Itoa(spotinst.IntValue(t.ScaleTargetCapacity))
@@ -2,7 +2,6 @@ package elastigroup_gcp_launch_configuration
 
 import (
 	"bytes"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"strconv"
@@ -351,27 +350,16 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 				}
 				return commons.SuppressIfImportedFromGKE(k, old, new, d)
 			},
-			StateFunc: Base64StateFunc,
+			StateFunc: commons.Base64StateFunc,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var value = ""
-			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
-				elastigroup.Compute.LaunchSpecification.ShutdownScript != nil {
-
-				shutdownScript := elastigroup.Compute.LaunchSpecification.ShutdownScript
-				shutdownScriptValue := spotinst.StringValue(shutdownScript)
-				if shutdownScriptValue != "" {
-					if isBase64Encoded(resourceData.Get(string(ShutdownScript)).(string)) {
-						value = shutdownScriptValue
-					} else {
-						decodedShutdownScript, _ := base64.StdEncoding.DecodeString(shutdownScriptValue)
-						value = string(decodedShutdownScript)
-					}
-				}
+			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil {
+				value = spotinst.StringValue(elastigroup.Compute.LaunchSpecification.ShutdownScript)
 			}
-			if err := resourceData.Set(string(ShutdownScript), Base64StateFunc(value)); err != nil {
+			if err := resourceData.Set(string(ShutdownScript), value); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ShutdownScript), err)
 			}
 			return nil
@@ -380,7 +368,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.Get(string(ShutdownScript)).(string); ok && v != "" {
-				shutdownScript := spotinst.String(base64Encode(v))
+				shutdownScript := spotinst.String(commons.Base64Encode(v))
 				elastigroup.Compute.LaunchSpecification.SetShutdownScript(shutdownScript)
 			}
 			return nil
@@ -390,7 +378,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup := egWrapper.GetElastigroup()
 			var shutdownScript *string = nil
 			if v, ok := resourceData.Get(string(ShutdownScript)).(string); ok && v != "" {
-				shutdownScript = spotinst.String(base64Encode(v))
+				shutdownScript = spotinst.String(commons.Base64Encode(v))
 			}
 			elastigroup.Compute.LaunchSpecification.SetShutdownScript(shutdownScript)
 			return nil
@@ -492,31 +480,6 @@ func hashKV(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func Base64StateFunc(v interface{}) string {
-	if isBase64Encoded(v.(string)) {
-		return v.(string)
-	} else {
-		return base64Encode(v.(string))
-	}
-}
-
-// base64Encode encodes data if the input isn't already encoded using
-// base64.StdEncoding.EncodeToString. If the input is already base64 encoded,
-// return the original input unchanged.
-func base64Encode(data string) string {
-	// Check whether the data is already Base64 encoded; don't double-encode
-	if isBase64Encoded(data) {
-		return data
-	}
-	// data has not been encoded encode and return
-	return base64.StdEncoding.EncodeToString([]byte(data))
-}
-
-func isBase64Encoded(data string) bool {
-	_, err := base64.StdEncoding.DecodeString(data)
-	return err == nil
-}
-
 // flattenLabels flattens the labels struct
 func flattenLabels(labels []*gcp.Label) []interface{} {
 	result := make([]interface{}, 0, len(labels))
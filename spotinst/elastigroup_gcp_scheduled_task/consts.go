@@ -7,6 +7,7 @@ const (
 	IsEnabled      commons.FieldName = "is_enabled"
 	TaskType       commons.FieldName = "task_type"
 	CronExpression commons.FieldName = "cron_expression"
+	NextExecutions commons.FieldName = "next_executions"
 	TargetCapacity commons.FieldName = "target_capacity"
 	MinCapacity    commons.FieldName = "min_capacity"
 	MaxCapacity    commons.FieldName = "max_capacity"
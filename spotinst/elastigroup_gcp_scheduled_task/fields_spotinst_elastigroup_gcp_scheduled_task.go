@@ -32,10 +32,13 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(CronExpression): {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: commons.ValidateCronExpression,
 					},
 
+					string(NextExecutions): commons.NextExecutionsSchema(),
+
 					string(TargetCapacity): {
 						Type:     schema.TypeString,
 						Optional: true,
@@ -109,6 +112,7 @@ func flattenGCPGroupScheduledTasks(tasks []*gcp.Task) []interface{} {
 		m[string(IsEnabled)] = spotinst.BoolValue(t.IsEnabled)
 		m[string(TaskType)] = spotinst.StringValue(t.Type)
 		m[string(CronExpression)] = spotinst.StringValue(t.CronExpression)
+		m[string(NextExecutions)] = commons.CronNextExecutions(spotinst.StringValue(t.CronExpression))
 
 		if t.TargetCapacity != nil {
 			m[string(TargetCapacity)] = strconv.Itoa(spotinst.IntValue(t.TargetCapacity))
@@ -2,6 +2,12 @@ package health_check
 
 import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 
+const (
+	ProtocolHTTP  = "http"
+	ProtocolHTTPS = "https"
+	ProtocolTCP   = "tcp"
+)
+
 const (
 	Name       commons.FieldName = "name"
 	ResourceId commons.FieldName = "resource_id"
@@ -16,6 +22,16 @@ const (
 	Unhealthy  commons.FieldName = "unhealthy"
 	Healthy    commons.FieldName = "healthy"
 
+	Headers              commons.FieldName = "headers"
+	HeaderName           commons.FieldName = "name"
+	HeaderValue          commons.FieldName = "value"
+	ExpectedResponseBody commons.FieldName = "expected_response_body"
+	GracePeriod          commons.FieldName = "grace_period"
+
+	// Computed fields
+	HealthyInstances   commons.FieldName = "healthy_instances"
+	UnhealthyInstances commons.FieldName = "unhealthy_instances"
+
 	// Deprecated: EndPoint is obsolete, exists for backward compatibility only,
 	// and should not be used. Please use Endpoint instead.
 	EndPoint commons.FieldName = "end_point"
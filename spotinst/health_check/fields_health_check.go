@@ -2,8 +2,10 @@ package health_check
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/healthcheck"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -165,8 +167,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					string(Protocol): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{ProtocolHTTP, ProtocolHTTPS, ProtocolTCP}, true),
 					},
 
 					string(Port): {
@@ -208,6 +211,35 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Type:     schema.TypeInt,
 						Required: true,
 					},
+
+					string(Headers): {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								string(HeaderName): {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+
+								string(HeaderValue): {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+
+					string(ExpectedResponseBody): {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+
+					string(GracePeriod): {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
 				},
 			},
 		},
@@ -218,10 +250,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			hcWrapper := resourceObject.(*commons.HealthCheckWrapper)
 			healthCheck := hcWrapper.GetHealthCheck()
 			if v, ok := resourceData.GetOk(string(Check)); ok {
-				if check, err := expandCheck(v); err != nil {
+				if check, options, err := expandCheck(v); err != nil {
 					return err
 				} else {
 					healthCheck.SetCheck(check)
+					hcWrapper.CheckOptions = options
 				}
 			}
 			return nil
@@ -230,26 +263,114 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			hcWrapper := resourceObject.(*commons.HealthCheckWrapper)
 			healthCheck := hcWrapper.GetHealthCheck()
 			var value *healthcheck.Check = nil
+			options := &commons.HealthCheckOptions{}
 			if v, ok := resourceData.GetOk(string(Check)); ok {
-				if integration, err := expandCheck(v); err != nil {
+				if integration, checkOptions, err := expandCheck(v); err != nil {
 					return err
 				} else {
 					value = integration
+					options = checkOptions
 				}
 			}
 			healthCheck.SetCheck(value)
+			hcWrapper.CheckOptions = options
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[HealthyInstances] = commons.NewGenericField(
+		commons.HealthCheck,
+		HealthyInstances,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			hcWrapper := resourceObject.(*commons.HealthCheckWrapper)
+			var value *int = nil
+			if hcWrapper.InstancesHealth != nil {
+				value = spotinst.Int(hcWrapper.InstancesHealth.Healthy)
+			}
+			if err := resourceData.Set(string(HealthyInstances), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(HealthyInstances), err)
+			}
 			return nil
 		},
 		nil,
+		nil,
+		nil,
 	)
 
+	fieldsMap[UnhealthyInstances] = commons.NewGenericField(
+		commons.HealthCheck,
+		UnhealthyInstances,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			hcWrapper := resourceObject.(*commons.HealthCheckWrapper)
+			var value *int = nil
+			if hcWrapper.InstancesHealth != nil {
+				value = spotinst.Int(hcWrapper.InstancesHealth.Unhealthy)
+			}
+			if err := resourceData.Set(string(UnhealthyInstances), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(UnhealthyInstances), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
 }
 
-func expandCheck(data interface{}) (*healthcheck.Check, error) {
+// ValidateCheck is a schema.CustomizeDiffFunc that requires an endpoint for
+// HTTP and HTTPS checks, and rejects an endpoint, headers or an expected
+// response body for TCP checks, at plan time.
+func ValidateCheck(diff *schema.ResourceDiff, meta interface{}) error {
+	prefix := fmt.Sprintf("%s.0.", string(Check))
+	if !diff.NewValueKnown(prefix+string(Protocol)) ||
+		!diff.NewValueKnown(prefix+string(Endpoint)) ||
+		!diff.NewValueKnown(prefix+string(EndPoint)) {
+		return nil
+	}
+
+	protocol := strings.ToLower(diff.Get(prefix + string(Protocol)).(string))
+	endpoint := diff.Get(prefix + string(Endpoint)).(string)
+	if endpoint == "" {
+		endpoint = diff.Get(prefix + string(EndPoint)).(string)
+	}
+
+	switch protocol {
+	case ProtocolHTTP, ProtocolHTTPS:
+		if endpoint == "" {
+			return fmt.Errorf("%s: %s is required for protocol %q", string(Check), string(Endpoint), protocol)
+		}
+	case ProtocolTCP:
+		if endpoint != "" {
+			return fmt.Errorf("%s: %s must not be set for protocol %q, only the port is checked",
+				string(Check), string(Endpoint), protocol)
+		}
+		if headers, ok := diff.Get(prefix + string(Headers)).([]interface{}); ok && len(headers) > 0 {
+			return fmt.Errorf("%s: %s must not be set for protocol %q, only the port is checked",
+				string(Check), string(Headers), protocol)
+		}
+		if body, ok := diff.Get(prefix + string(ExpectedResponseBody)).(string); ok && body != "" {
+			return fmt.Errorf("%s: %s must not be set for protocol %q, only the port is checked",
+				string(Check), string(ExpectedResponseBody), protocol)
+		}
+	}
+	return nil
+}
+
+func expandCheck(data interface{}) (*healthcheck.Check, *commons.HealthCheckOptions, error) {
 	check := &healthcheck.Check{}
+	options := &commons.HealthCheckOptions{}
 	list := data.([]interface{})
 	if list == nil || list[0] == nil {
-		return check, nil
+		return check, options, nil
 	}
 	m := list[0].(map[string]interface{})
 
@@ -297,5 +418,32 @@ func expandCheck(data interface{}) (*healthcheck.Check, error) {
 		check.SetHealthy(nil)
 	}
 
-	return check, nil
+	if v, ok := m[string(Headers)].([]interface{}); ok && len(v) > 0 {
+		options.Headers = expandHeaders(v)
+	}
+
+	if v, ok := m[string(ExpectedResponseBody)].(string); ok && v != "" {
+		options.ExpectedBody = spotinst.String(v)
+	}
+
+	if v, ok := m[string(GracePeriod)].(int); ok && v > 0 {
+		options.GracePeriod = spotinst.Int(v)
+	}
+
+	return check, options, nil
+}
+
+func expandHeaders(data []interface{}) []*commons.HealthCheckHeader {
+	headers := make([]*commons.HealthCheckHeader, 0, len(data))
+	for _, item := range data {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		headers = append(headers, &commons.HealthCheckHeader{
+			Name:  spotinst.String(m[string(HeaderName)].(string)),
+			Value: spotinst.String(m[string(HeaderValue)].(string)),
+		})
+	}
+	return headers
 }
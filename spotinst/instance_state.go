@@ -0,0 +1,106 @@
+package spotinst
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// instanceStateFuncs are the calls used to move an instance, e.g. a managed
+// instance or a stateful instance, between states.
+type instanceStateFuncs struct {
+	// name identifies the instance in errors, e.g. "ManagedInstance smi-123".
+	name string
+
+	read   func() (*string, error)
+	pause  func() error
+	resume func() error
+}
+
+// convergeInstanceState pauses or resumes the instance to reach the desired
+// state, and waits for it. An instance that is between states is waited for
+// before it is paused or resumed.
+func convergeInstanceState(desired string, timeout int, funcs instanceStateFuncs) error {
+	requested := false
+
+	err := resource.Retry(time.Second*time.Duration(timeout), func() *resource.RetryError {
+		status, err := funcs.read()
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		current := instanceStateFromStatus(status)
+		if spotinst.StringValue(current) == desired {
+			return nil
+		}
+
+		if current != nil && !requested {
+			switch desired {
+			case commons.InstanceStateRunning:
+				err = funcs.resume()
+			case commons.InstanceStatePaused:
+				err = funcs.pause()
+			}
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			requested = true
+		}
+
+		return resource.RetryableError(fmt.Errorf("%s is %q, waiting for it to be %s",
+			funcs.name, spotinst.StringValue(status), desired))
+	})
+
+	if err != nil {
+		return fmt.Errorf("[ERROR] failed to set %s to %s: %s", funcs.name, desired, err)
+	}
+	return nil
+}
+
+// instanceStateFromStatus maps the status of an instance to its state. It
+// returns nil while the instance is between states, so that the configured
+// state is kept on read and an ongoing transition does not show up as a change.
+func instanceStateFromStatus(status *string) *string {
+	switch strings.ToLower(spotinst.StringValue(status)) {
+	case "active":
+		return spotinst.String(commons.InstanceStateRunning)
+	case "paused":
+		return spotinst.String(commons.InstanceStatePaused)
+	default:
+		return nil
+	}
+}
+
+// updateInstanceState converges the instance when its state changed. The
+// previous state is kept in state if the transition fails, so that the next
+// apply runs it again.
+func updateInstanceState(resourceData *schema.ResourceData, changeset *commons.Changeset, converge func() error) error {
+	if !changeset.HasChanges() {
+		return nil
+	}
+
+	resourceData.Partial(true)
+	if err := converge(); err != nil {
+		return changeset.WrapError(err)
+	}
+	resourceData.Partial(false)
+
+	return nil
+}
+
+// deleteInstanceState returns a Delete func that only removes the resource
+// from state, the instance is left in its current state.
+func deleteInstanceState(resourceName string) schema.DeleteFunc {
+	return func(resourceData *schema.ResourceData, meta interface{}) error {
+		log.Printf(string(commons.ResourceOnDelete), resourceName, resourceData.Id())
+
+		resourceData.SetId("")
+		return nil
+	}
+}
@@ -20,6 +20,20 @@ const (
 	ExposeClusterID   commons.FieldName = "expose_cluster_id"
 	OutputClusterID   commons.FieldName = "output_cluster_id"
 
+	WaitForSteps        commons.FieldName = "wait_for_steps"
+	WaitForStepsTimeout commons.FieldName = "wait_for_steps_timeout"
+
+	// Computed fields
+	ClusterState          commons.FieldName = "cluster_state"
+	MasterPublicDNS       commons.FieldName = "master_public_dns"
+	MasterInstanceGroupID commons.FieldName = "master_instance_group_id"
+	CoreInstanceGroupID   commons.FieldName = "core_instance_group_id"
+	TaskInstanceGroupID   commons.FieldName = "task_instance_group_id"
+	Steps                 commons.FieldName = "steps"
+	StepID                commons.FieldName = "id"
+	StepName              commons.FieldName = "name"
+	StepStatus            commons.FieldName = "status"
+
 	ConfigurationsFile   commons.FieldName = "configurations_file"
 	BootstrapActionsFile commons.FieldName = "bootstrap_actions_file"
 	StepsFile            commons.FieldName = "steps_file"
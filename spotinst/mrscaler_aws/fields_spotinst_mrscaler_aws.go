@@ -11,6 +11,9 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/mrscaler"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/mrscaler_aws_cluster"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/mrscaler_aws_instance_groups"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/mrscaler_aws_scaling_policies"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/mrscaler_aws_strategy"
 )
 
@@ -213,6 +216,172 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[WaitForSteps] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		WaitForSteps,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForStepsTimeout] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		WaitForStepsTimeout,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  3600,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[ClusterState] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		ClusterState,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			var value *string = nil
+			if mrsWrapper.Cluster != nil {
+				value = mrsWrapper.Cluster.State
+			}
+			if err := resourceData.Set(string(ClusterState), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ClusterState), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[MasterPublicDNS] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		MasterPublicDNS,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			var value *string = nil
+			if mrsWrapper.Cluster != nil {
+				value = mrsWrapper.Cluster.MasterPublicDNSName
+			}
+			if err := resourceData.Set(string(MasterPublicDNS), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MasterPublicDNS), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[MasterInstanceGroupID] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		MasterInstanceGroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			var value *string = nil
+			if mrsWrapper.Cluster != nil {
+				value = clusterInstanceGroupID(mrsWrapper.Cluster, "MASTER")
+			}
+			if err := resourceData.Set(string(MasterInstanceGroupID), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MasterInstanceGroupID), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[CoreInstanceGroupID] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		CoreInstanceGroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			var value *string = nil
+			if mrsWrapper.Cluster != nil {
+				value = clusterInstanceGroupID(mrsWrapper.Cluster, "CORE")
+			}
+			if err := resourceData.Set(string(CoreInstanceGroupID), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(CoreInstanceGroupID), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[TaskInstanceGroupID] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		TaskInstanceGroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			var value *string = nil
+			if mrsWrapper.Cluster != nil {
+				value = clusterInstanceGroupID(mrsWrapper.Cluster, "TASK")
+			}
+			if err := resourceData.Set(string(TaskInstanceGroupID), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TaskInstanceGroupID), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[Steps] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		Steps,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(StepID): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(StepName): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(StepStatus): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			mrsWrapper := resourceObject.(*commons.MRScalerAWSWrapper)
+			var value []interface{} = nil
+			if mrsWrapper.Cluster != nil {
+				value = flattenClusterSteps(mrsWrapper.Cluster.Steps)
+			}
+			if err := resourceData.Set(string(Steps), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Steps), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
 	fieldsMap[AvailabilityZones] = commons.NewGenericField(
 		commons.MRScalerAWS,
 		AvailabilityZones,
@@ -1065,6 +1234,27 @@ func expandInstanceWeights(data interface{}) ([]*mrscaler.InstanceWeight, error)
 	return weights, nil
 }
 
+func clusterInstanceGroupID(cluster *commons.MRScalerAWSClusterStatus, groupType string) *string {
+	for _, group := range cluster.InstanceGroups {
+		if strings.EqualFold(spotinst.StringValue(group.Type), groupType) {
+			return group.ID
+		}
+	}
+	return nil
+}
+
+func flattenClusterSteps(steps []*commons.MRScalerAWSClusterStep) []interface{} {
+	result := make([]interface{}, 0, len(steps))
+	for _, step := range steps {
+		result = append(result, map[string]interface{}{
+			string(StepID):     spotinst.StringValue(step.ID),
+			string(StepName):   spotinst.StringValue(step.Name),
+			string(StepStatus): spotinst.StringValue(step.Status),
+		})
+	}
+	return result
+}
+
 func flattenS3File(file *mrscaler.S3File) []interface{} {
 	m := make(map[string]interface{})
 	m[string(Bucket)] = spotinst.StringValue(file.Bucket)
@@ -1091,3 +1281,127 @@ func hashKV(v interface{}) int {
 	buf.WriteString(fmt.Sprintf("%s-", m[string(TagValue)].(string)))
 	return hashcode.String(buf.String())
 }
+
+// newClusterFields are the arguments that only apply when the scaler
+// provisions the EMR cluster itself.
+var newClusterFields = []commons.FieldName{
+	mrscaler_aws_strategy.ReleaseLabel,
+	Applications,
+	ManagedPrimarySecurityGroup,
+	ManagedReplicaSecurityGroup,
+	ServiceAccessSecurityGroup,
+	AddlPrimarySecurityGroups,
+	AddlReplicaSecurityGroups,
+	CustomAMIID,
+	RepoUpgradeOnBoot,
+	EC2KeyName,
+	mrscaler_aws_cluster.LogURI,
+	mrscaler_aws_cluster.AdditionalInfo,
+	mrscaler_aws_cluster.JobFlowRole,
+	mrscaler_aws_cluster.SecurityConfig,
+	mrscaler_aws_cluster.ServiceRole,
+	mrscaler_aws_cluster.TerminationProtected,
+	mrscaler_aws_cluster.KeepJobFlowAlive,
+}
+
+// clonedClusterFields are the arguments that describe the cluster launched
+// by the clone and new strategies, and have no meaning for a wrapped cluster.
+var clonedClusterFields = []commons.FieldName{
+	mrscaler_aws_strategy.Retries,
+	mrscaler_aws_strategy.ProvisioningTimeout,
+	AvailabilityZones,
+	Tags,
+	ConfigurationsFile,
+	BootstrapActionsFile,
+	StepsFile,
+	EBSRootVolumeSize,
+	mrscaler_aws_instance_groups.MasterInstanceTypes,
+	mrscaler_aws_instance_groups.MasterLifecycle,
+	mrscaler_aws_instance_groups.MasterEBSOptimized,
+	mrscaler_aws_instance_groups.MasterEBSBlockDevice,
+	mrscaler_aws_instance_groups.CoreInstanceTypes,
+	mrscaler_aws_instance_groups.CoreMin,
+	mrscaler_aws_instance_groups.CoreMax,
+	mrscaler_aws_instance_groups.CoreTarget,
+	mrscaler_aws_instance_groups.CoreLifecycle,
+	mrscaler_aws_instance_groups.CoreEBSOptimized,
+	mrscaler_aws_instance_groups.CoreEBSBlockDevice,
+	mrscaler_aws_scaling_policies.CoreScalingUpPolicy,
+	mrscaler_aws_scaling_policies.CoreScalingDownPolicy,
+}
+
+// taskGroupFields are the arguments that configure the task group, which is
+// optional when wrapping an existing cluster.
+var taskGroupFields = []commons.FieldName{
+	mrscaler_aws_instance_groups.TaskMin,
+	mrscaler_aws_instance_groups.TaskMax,
+	mrscaler_aws_instance_groups.TaskTarget,
+	mrscaler_aws_instance_groups.TaskLifecycle,
+	mrscaler_aws_instance_groups.TaskEBSOptimized,
+	mrscaler_aws_instance_groups.TaskEBSBlockDevice,
+	mrscaler_aws_scaling_policies.TaskScalingUpPolicy,
+	mrscaler_aws_scaling_policies.TaskScalingDownPolicy,
+}
+
+// ValidateStrategy is a schema.CustomizeDiffFunc that checks at plan time that
+// the arguments match the strategy: clone and wrap require an existing
+// cluster, new requires a release label and the master and core groups, and
+// arguments the strategy does not use are rejected.
+func ValidateStrategy(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown(string(Strategy)) {
+		return nil
+	}
+	strategy := diff.Get(string(Strategy)).(string)
+
+	var required, forbidden []commons.FieldName
+	switch strategy {
+	case mrscaler_aws_strategy.New:
+		required = []commons.FieldName{
+			mrscaler_aws_strategy.ReleaseLabel,
+			mrscaler_aws_instance_groups.MasterInstanceTypes,
+			mrscaler_aws_instance_groups.CoreInstanceTypes,
+		}
+		forbidden = []commons.FieldName{ClusterID}
+	case mrscaler_aws_strategy.Clone:
+		required = []commons.FieldName{ClusterID}
+		forbidden = newClusterFields
+	case mrscaler_aws_strategy.Wrap:
+		required = []commons.FieldName{ClusterID}
+		forbidden = append(append([]commons.FieldName{}, newClusterFields...), clonedClusterFields...)
+
+		// The task group may be omitted to link the cluster as is, but once
+		// configured it needs its instance types.
+		if !isFieldSet(diff, mrscaler_aws_instance_groups.TaskInstanceTypes) {
+			for _, field := range taskGroupFields {
+				if isFieldSet(diff, field) {
+					return fmt.Errorf("%s is set but %s is not, either configure the task group or remove it",
+						string(field), string(mrscaler_aws_instance_groups.TaskInstanceTypes))
+				}
+			}
+		}
+	default:
+		return nil
+	}
+
+	for _, field := range required {
+		if !isFieldSet(diff, field) {
+			return fmt.Errorf("%s is required when %s is %q", string(field), string(Strategy), strategy)
+		}
+	}
+	for _, field := range forbidden {
+		if isFieldSet(diff, field) {
+			return fmt.Errorf("%s cannot be set when %s is %q", string(field), string(Strategy), strategy)
+		}
+	}
+	return nil
+}
+
+// isFieldSet reports whether a field is set in the configuration, treating a
+// value that is not known until apply as set.
+func isFieldSet(diff *schema.ResourceDiff, field commons.FieldName) bool {
+	if !diff.NewValueKnown(string(field)) {
+		return true
+	}
+	_, ok := diff.GetOk(string(field))
+	return ok
+}
@@ -8,6 +8,7 @@ const (
 	TaskType          commons.FieldName = "task_type"
 	InstanceGroupType commons.FieldName = "instance_group_type"
 	CronExpression    commons.FieldName = "cron"
+	NextExecutions    commons.FieldName = "next_executions"
 	TargetCapacity    commons.FieldName = "desired_capacity"
 	MinCapacity       commons.FieldName = "min_capacity"
 	MaxCapacity       commons.FieldName = "max_capacity"
@@ -37,10 +37,13 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(CronExpression): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: commons.ValidateCronExpression,
 					},
 
+					string(NextExecutions): commons.NextExecutionsSchema(),
+
 					string(TargetCapacity): {
 						Type:     schema.TypeString,
 						Optional: true,
@@ -115,6 +118,7 @@ func flattenAWSGroupScheduledTasks(tasks []*mrscaler.Task) []interface{} {
 		m[string(TaskType)] = spotinst.StringValue(t.Type)
 		m[string(InstanceGroupType)] = spotinst.StringValue(t.InstanceGroupType)
 		m[string(CronExpression)] = spotinst.StringValue(t.CronExpression)
+		m[string(NextExecutions)] = commons.CronNextExecutions(spotinst.StringValue(t.CronExpression))
 
 		if t.TargetCapacity != nil {
 			m[string(TargetCapacity)] = strconv.Itoa(spotinst.IntValue(t.TargetCapacity))
@@ -0,0 +1,22 @@
+package multai_certificate
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	Name                commons.FieldName = "name"
+	CertificatePEM      commons.FieldName = "certificate_pem"
+	PrivateKeyPEM       commons.FieldName = "private_key_pem"
+	CertificateChainPEM commons.FieldName = "certificate_chain_pem"
+	Tags                commons.FieldName = "tags"
+
+	TagKey   commons.FieldName = "key"
+	TagValue commons.FieldName = "value"
+
+	// Computed fields, parsed from the certificate.
+	NotBefore    commons.FieldName = "not_before"
+	NotAfter     commons.FieldName = "not_after"
+	Subject      commons.FieldName = "subject"
+	Issuer       commons.FieldName = "issuer"
+	SerialNumber commons.FieldName = "serial_number"
+	DNSNames     commons.FieldName = "dns_names"
+)
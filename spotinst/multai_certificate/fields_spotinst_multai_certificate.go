@@ -0,0 +1,434 @@
+package multai_certificate
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+	// The API names the private key differently than the Terraform attribute,
+	// register its name as well to keep it out of the logs.
+	commons.RegisterSensitiveKeys("key_pem_block")
+
+	fieldsMap[Name] = commons.NewGenericField(
+		commons.MultaiCertificate,
+		Name,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			var value *string = nil
+			if certificate.Name != nil {
+				value = certificate.Name
+			}
+			if err := resourceData.Set(string(Name), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Name), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			certificate.Name = spotinst.String(resourceData.Get(string(Name)).(string))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			certificate.Name = spotinst.String(resourceData.Get(string(Name)).(string))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[CertificatePEM] = commons.NewGenericField(
+		commons.MultaiCertificate,
+		CertificatePEM,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateCertificatePEM,
+			StateFunc:    NormalizePEM,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			if certificate.CertPEMBlock != nil {
+				leaf, _ := splitCertificatePEMBlock(spotinst.StringValue(certificate.CertPEMBlock))
+				if err := resourceData.Set(string(CertificatePEM), leaf); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(CertificatePEM), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			certificate.CertPEMBlock = expandCertificatePEMBlock(resourceData)
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			certificate.CertPEMBlock = expandCertificatePEMBlock(resourceData)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[CertificateChainPEM] = commons.NewGenericField(
+		commons.MultaiCertificate,
+		CertificateChainPEM,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateCertificatePEM,
+			StateFunc:    NormalizePEM,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			if certificate.CertPEMBlock != nil {
+				_, chain := splitCertificatePEMBlock(spotinst.StringValue(certificate.CertPEMBlock))
+				if err := resourceData.Set(string(CertificateChainPEM), chain); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(CertificateChainPEM), err)
+				}
+			}
+			return nil
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			// The chain is sent as part of the certificate PEM block.
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			certificate.CertPEMBlock = expandCertificatePEMBlock(resourceData)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[PrivateKeyPEM] = commons.NewGenericField(
+		commons.MultaiCertificate,
+		PrivateKeyPEM,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validatePrivateKeyPEM,
+			StateFunc:    PrivateKeyStateFunc,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			// The private key is never returned by the API, only its hash is
+			// kept in the state.
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			certificate.KeyPEMBlock = spotinst.String(resourceData.Get(string(PrivateKeyPEM)).(string))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			certificate.KeyPEMBlock = spotinst.String(resourceData.Get(string(PrivateKeyPEM)).(string))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Tags] = commons.NewGenericField(
+		commons.MultaiCertificate,
+		Tags,
+		&schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(TagKey): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(TagValue): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+			Set: hashKV,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			if value, ok := resourceData.GetOk(string(Tags)); ok {
+				if tags, err := expandTags(value); err != nil {
+					return err
+				} else {
+					certificate.Tags = tags
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			var tagsToAdd []*multai.Tag = nil
+			if value, ok := resourceData.GetOk(string(Tags)); ok {
+				if tags, err := expandTags(value); err != nil {
+					return err
+				} else {
+					tagsToAdd = tags
+				}
+			}
+			certificate.Tags = tagsToAdd
+			return nil
+		},
+		nil,
+	)
+
+	setupComputedField(fieldsMap, NotBefore, schema.TypeString, func(cert *x509.Certificate) interface{} {
+		return cert.NotBefore.UTC().Format(time.RFC3339)
+	})
+
+	setupComputedField(fieldsMap, NotAfter, schema.TypeString, func(cert *x509.Certificate) interface{} {
+		return cert.NotAfter.UTC().Format(time.RFC3339)
+	})
+
+	setupComputedField(fieldsMap, Subject, schema.TypeString, func(cert *x509.Certificate) interface{} {
+		return cert.Subject.String()
+	})
+
+	setupComputedField(fieldsMap, Issuer, schema.TypeString, func(cert *x509.Certificate) interface{} {
+		return cert.Issuer.String()
+	})
+
+	setupComputedField(fieldsMap, SerialNumber, schema.TypeString, func(cert *x509.Certificate) interface{} {
+		return cert.SerialNumber.String()
+	})
+
+	setupComputedField(fieldsMap, DNSNames, schema.TypeList, func(cert *x509.Certificate) interface{} {
+		return cert.DNSNames
+	})
+}
+
+// setupComputedField adds a computed field whose value is parsed locally from
+// the certificate.
+func setupComputedField(fieldsMap map[commons.FieldName]*commons.GenericField,
+	fieldName commons.FieldName, valueType schema.ValueType, value func(*x509.Certificate) interface{}) {
+
+	s := &schema.Schema{
+		Type:     valueType,
+		Computed: true,
+	}
+	if valueType == schema.TypeList {
+		s.Elem = &schema.Schema{Type: schema.TypeString}
+	}
+
+	fieldsMap[fieldName] = commons.NewGenericField(
+		commons.MultaiCertificate,
+		fieldName,
+		s,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			cert, err := parseCertificate(certificate, resourceData)
+			if err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(fieldName), err)
+			}
+			if err := resourceData.Set(string(fieldName), value(cert)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(fieldName), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+}
+
+// ValidateCertificate is a schema.CustomizeDiffFunc that checks at plan time
+// that the private key matches the certificate, and marks the attributes
+// parsed from the certificate as changing when the certificate does.
+func ValidateCertificate(diff *schema.ResourceDiff, meta interface{}) error {
+	certPEM := diff.Get(string(CertificatePEM)).(string)
+	keyPEM := diff.Get(string(PrivateKeyPEM)).(string)
+
+	// Values that are not known yet are empty, and an unchanged private key
+	// is only known by its hash, so neither can be checked.
+	if certPEM != "" && isPEM(keyPEM) {
+		if _, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM)); err != nil {
+			return fmt.Errorf("%s does not match %s: %v", string(PrivateKeyPEM), string(CertificatePEM), err)
+		}
+	}
+
+	if diff.Id() != "" && diff.HasChange(string(CertificatePEM)) {
+		for _, fieldName := range []commons.FieldName{NotBefore, NotAfter, Subject, Issuer, SerialNumber, DNSNames} {
+			if err := diff.SetNewComputed(string(fieldName)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// NormalizePEM re-encodes the PEM blocks of v, so that certificates that only
+// differ in whitespace are stored the same way.
+func NormalizePEM(v interface{}) string {
+	rest := []byte(strings.TrimSpace(v.(string)))
+	var buf bytes.Buffer
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		buf.Write(pem.EncodeToMemory(block))
+	}
+	if buf.Len() == 0 {
+		return strings.TrimSpace(v.(string))
+	}
+	return buf.String()
+}
+
+// PrivateKeyStateFunc stores the SHA-256 sum of the private key in the state
+// instead of the key itself.
+func PrivateKeyStateFunc(v interface{}) string {
+	value := v.(string)
+	if value == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(hash[:])
+}
+
+func expandCertificatePEMBlock(resourceData *schema.ResourceData) *string {
+	block := resourceData.Get(string(CertificatePEM)).(string)
+	if chain, ok := resourceData.GetOk(string(CertificateChainPEM)); ok {
+		block = strings.TrimSpace(block) + "\n" + chain.(string)
+	}
+	return spotinst.String(block)
+}
+
+// splitCertificatePEMBlock splits a PEM block as stored by the API into the
+// certificate, which comes first, and its chain.
+func splitCertificatePEMBlock(block string) (string, string) {
+	leaf, rest := pem.Decode([]byte(strings.TrimSpace(block)))
+	if leaf == nil {
+		return NormalizePEM(block), ""
+	}
+	return string(pem.EncodeToMemory(leaf)), NormalizePEM(string(rest))
+}
+
+// parseCertificate parses the certificate returned by the API, or the one in
+// the configuration when the API does not return it.
+func parseCertificate(certificate *multai.Certificate, resourceData *schema.ResourceData) (*x509.Certificate, error) {
+	var data string
+	if certificate.CertPEMBlock != nil {
+		data = spotinst.StringValue(certificate.CertPEMBlock)
+	} else {
+		data = resourceData.Get(string(CertificatePEM)).(string)
+	}
+	block, _ := pem.Decode([]byte(strings.TrimSpace(data)))
+	if block == nil {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func validateCertificatePEM(v interface{}, k string) ([]string, []error) {
+	rest := []byte(strings.TrimSpace(v.(string)))
+	count := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, []error{fmt.Errorf("%q: unexpected PEM block of type %q", k, block.Type)}
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return nil, []error{fmt.Errorf("%q: invalid certificate: %v", k, err)}
+		}
+		count++
+	}
+	if count == 0 {
+		return nil, []error{fmt.Errorf("%q: no PEM encoded certificate found", k)}
+	}
+	return nil, nil
+}
+
+func validatePrivateKeyPEM(v interface{}, k string) ([]string, []error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(v.(string))))
+	if block == nil {
+		return nil, []error{fmt.Errorf("%q: no PEM encoded private key found", k)}
+	}
+	if _, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		return nil, nil
+	}
+	if _, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return nil, nil
+	}
+	if _, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return nil, nil
+	}
+	return nil, []error{fmt.Errorf("%q: unsupported private key of type %q, expected a PKCS #1, PKCS #8 or EC key", k, block.Type)}
+}
+
+func isPEM(value string) bool {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(value)))
+	return block != nil
+}
+
+func expandTags(data interface{}) ([]*multai.Tag, error) {
+	list := data.(*schema.Set).List()
+	tags := make([]*multai.Tag, 0, len(list))
+	for _, v := range list {
+		attr, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := attr[string(TagKey)]; !ok {
+			return nil, errors.New("invalid tag attributes: key missing")
+		}
+
+		if _, ok := attr[string(TagValue)]; !ok {
+			return nil, errors.New("invalid tag attributes: value missing")
+		}
+		tag := &multai.Tag{
+			Key:   spotinst.String(attr[string(TagKey)].(string)),
+			Value: spotinst.String(attr[string(TagValue)].(string)),
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+func hashKV(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m[string(TagKey)].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m[string(TagValue)].(string)))
+	return hashcode.String(buf.String())
+}
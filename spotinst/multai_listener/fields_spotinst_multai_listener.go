@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/multai"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/util/stringutil"
@@ -129,8 +131,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(MinVersion): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(tlsVersionNames(), true),
 						StateFunc: func(v interface{}) string {
 							value := v.(string)
 							return strings.ToUpper(value)
@@ -138,8 +141,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(MaxVersion): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(tlsVersionNames(), true),
 						StateFunc: func(v interface{}) string {
 							value := v.(string)
 							return strings.ToUpper(value)
@@ -159,7 +163,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					string(CipherSuites): {
 						Type:     schema.TypeList,
 						Required: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice(tlsCipherSuiteNames(), false),
+						},
 					},
 				},
 			},
@@ -370,3 +377,50 @@ func hashBalancerListenerTLSConfig(v interface{}) int {
 	}
 	return hashcode.String(buf.String())
 }
+
+// ValidateTLSConfig is a schema.CustomizeDiffFunc that rejects a TLS
+// configuration whose minimum version is higher than its maximum version at
+// plan time.
+func ValidateTLSConfig(diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk(string(TLSConfig))
+	if !ok {
+		return nil
+	}
+	for _, item := range v.(*schema.Set).List() {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		minVersion, _ := m[string(MinVersion)].(string)
+		maxVersion, _ := m[string(MaxVersion)].(string)
+		minValue, minOk := multai.TLSVersionValue[strings.ToUpper(minVersion)]
+		maxValue, maxOk := multai.TLSVersionValue[strings.ToUpper(maxVersion)]
+		if minOk && maxOk && minValue > maxValue {
+			return fmt.Errorf("%s: %s (%s) must not be higher than %s (%s)", string(TLSConfig),
+				string(MinVersion), minVersion, string(MaxVersion), maxVersion)
+		}
+	}
+	return nil
+}
+
+// tlsVersionNames returns the names of the supported TLS versions, from the
+// oldest to the newest.
+func tlsVersionNames() []string {
+	names := make([]string, 0, len(multai.TLSVersionValue))
+	for name := range multai.TLSVersionValue {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return multai.TLSVersionValue[names[i]] < multai.TLSVersionValue[names[j]]
+	})
+	return names
+}
+
+func tlsCipherSuiteNames() []string {
+	names := make([]string, 0, len(multai.TLSCipherSuiteValue))
+	for name := range multai.TLSCipherSuiteValue {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,14 @@
+package multai_middleware
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	BalancerID commons.FieldName = "balancer_id"
+	Type       commons.FieldName = "type"
+	Priority   commons.FieldName = "priority"
+	Spec       commons.FieldName = "spec"
+	Tags       commons.FieldName = "tags"
+
+	TagKey   commons.FieldName = "key"
+	TagValue commons.FieldName = "value"
+)
@@ -0,0 +1,253 @@
+package multai_middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[BalancerID] = commons.NewGenericField(
+		commons.MultaiMiddleware,
+		BalancerID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			var value *string = nil
+			if middleware.BalancerID != nil {
+				value = middleware.BalancerID
+			}
+			if err := resourceData.Set(string(BalancerID), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(BalancerID), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			middleware.SetBalancerId(spotinst.String(resourceData.Get(string(BalancerID)).(string)))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[Type] = commons.NewGenericField(
+		commons.MultaiMiddleware,
+		Type,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			var value *string = nil
+			if middleware.Type != nil {
+				value = middleware.Type
+			}
+			if err := resourceData.Set(string(Type), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Type), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			middleware.SetType(spotinst.String(resourceData.Get(string(Type)).(string)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			middleware.SetType(spotinst.String(resourceData.Get(string(Type)).(string)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Priority] = commons.NewGenericField(
+		commons.MultaiMiddleware,
+		Priority,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			var value *int = nil
+			if middleware.Priority != nil {
+				value = middleware.Priority
+			}
+			if err := resourceData.Set(string(Priority), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Priority), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			middleware.SetPriority(spotinst.Int(resourceData.Get(string(Priority)).(int)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			middleware.SetPriority(spotinst.Int(resourceData.Get(string(Priority)).(int)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Spec] = commons.NewGenericField(
+		commons.MultaiMiddleware,
+		Spec,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc: func(v interface{}) string {
+				spec, _ := structure.NormalizeJsonString(v)
+				return spec
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			var value string
+			if len(middleware.Spec) > 0 {
+				spec, err := structure.NormalizeJsonString(string(middleware.Spec))
+				if err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Spec), err)
+				}
+				value = spec
+			}
+			if err := resourceData.Set(string(Spec), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Spec), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			if v, ok := resourceData.GetOk(string(Spec)); ok {
+				middleware.SetSpec(json.RawMessage(v.(string)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			var value json.RawMessage = nil
+			if v, ok := resourceData.GetOk(string(Spec)); ok {
+				value = json.RawMessage(v.(string))
+			}
+			middleware.SetSpec(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Tags] = commons.NewGenericField(
+		commons.MultaiMiddleware,
+		Tags,
+		&schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(TagKey): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(TagValue): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+			Set: hashKV,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			if value, ok := resourceData.GetOk(string(Tags)); ok {
+				if tags, err := expandTags(value); err != nil {
+					return err
+				} else {
+					middleware.SetTags(tags)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			var tagsToAdd []*multai.Tag = nil
+			if value, ok := resourceData.GetOk(string(Tags)); ok {
+				if tags, err := expandTags(value); err != nil {
+					return err
+				} else {
+					tagsToAdd = tags
+				}
+			}
+			middleware.SetTags(tagsToAdd)
+			return nil
+		},
+		nil,
+	)
+}
+
+func expandTags(data interface{}) ([]*multai.Tag, error) {
+	list := data.(*schema.Set).List()
+	tags := make([]*multai.Tag, 0, len(list))
+	for _, v := range list {
+		attr, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := attr[string(TagKey)]; !ok {
+			return nil, errors.New("invalid tag attributes: key missing")
+		}
+
+		if _, ok := attr[string(TagValue)]; !ok {
+			return nil, errors.New("invalid tag attributes: value missing")
+		}
+		tag := &multai.Tag{
+			Key:   spotinst.String(attr[string(TagKey)].(string)),
+			Value: spotinst.String(attr[string(TagValue)].(string)),
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+func hashKV(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m[string(TagKey)].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m[string(TagValue)].(string)))
+	return hashcode.String(buf.String())
+}
@@ -86,8 +86,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.MultaiRoutingRule,
 		Route,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Required: true,
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateRouteField,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			routingWrapper := resourceObject.(*commons.MultaiRoutingRuleWrapper)
@@ -0,0 +1,288 @@
+package multai_routing_rule
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// routeMatcher describes a matcher function of the route syntax, e.g.
+// Path(`/foo`), and the number of arguments it takes.
+type routeMatcher struct {
+	minArgs, maxArgs int // maxArgs is 0 for any number of arguments
+
+	// validate checks a single argument, given its index.
+	validate func(index int, arg string) error
+}
+
+var routeMatchers = map[string]routeMatcher{
+	"Host":         {minArgs: 1},
+	"HostRegexp":   {minArgs: 1},
+	"Path":         {minArgs: 1, validate: validateRoutePath},
+	"PathPrefix":   {minArgs: 1, validate: validateRoutePath},
+	"PathRegexp":   {minArgs: 1, validate: validateRouteRegexp(0)},
+	"Method":       {minArgs: 1, validate: validateRouteMethod},
+	"Header":       {minArgs: 2, maxArgs: 2},
+	"HeaderRegexp": {minArgs: 2, maxArgs: 2, validate: validateRouteRegexp(1)},
+	"Query":        {minArgs: 1},
+}
+
+type routeTokenKind int
+
+const (
+	routeTokenEOF routeTokenKind = iota
+	routeTokenIdent
+	routeTokenString
+	routeTokenLParen
+	routeTokenRParen
+	routeTokenComma
+	routeTokenAnd
+	routeTokenOr
+	routeTokenNot
+)
+
+type routeToken struct {
+	kind  routeTokenKind
+	value string
+	pos   int
+}
+
+func (t routeToken) String() string {
+	if t.kind == routeTokenEOF {
+		return "end of route"
+	}
+	return fmt.Sprintf("%q", t.value)
+}
+
+// ValidateRoute parses a route expression and checks its matchers, e.g.
+// "PathRegexp(`^/api/`) && (Host(`foo.com`) || !Method(`GET`))". Matchers are
+// combined with `&&`, `||` and `!`, and grouped with parentheses. Arguments
+// are quoted with backticks, or with double or single quotes.
+func ValidateRoute(route string) error {
+	tokens, err := tokenizeRoute(route)
+	if err != nil {
+		return err
+	}
+	p := &routeParser{tokens: tokens}
+	if err := p.parseOr(); err != nil {
+		return err
+	}
+	if t := p.peek(); t.kind != routeTokenEOF {
+		return fmt.Errorf("unexpected %s at position %d", t, t.pos+1)
+	}
+	return nil
+}
+
+func validateRouteField(v interface{}, k string) ([]string, []error) {
+	if err := ValidateRoute(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("invalid %s %q: %v", k, v.(string), err)}
+	}
+	return nil, nil
+}
+
+func tokenizeRoute(route string) ([]routeToken, error) {
+	var tokens []routeToken
+	for i := 0; i < len(route); {
+		c := route[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, routeToken{routeTokenLParen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, routeToken{routeTokenRParen, ")", i})
+			i++
+		case c == ',':
+			tokens = append(tokens, routeToken{routeTokenComma, ",", i})
+			i++
+		case c == '!':
+			tokens = append(tokens, routeToken{routeTokenNot, "!", i})
+			i++
+		case strings.HasPrefix(route[i:], "&&"):
+			tokens = append(tokens, routeToken{routeTokenAnd, "&&", i})
+			i += 2
+		case strings.HasPrefix(route[i:], "||"):
+			tokens = append(tokens, routeToken{routeTokenOr, "||", i})
+			i += 2
+		case c == '`' || c == '"' || c == '\'':
+			end := strings.IndexByte(route[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated argument starting at position %d", i+1)
+			}
+			tokens = append(tokens, routeToken{routeTokenString, route[i+1 : i+1+end], i})
+			i += end + 2
+		case unicode.IsLetter(rune(c)):
+			start := i
+			for i < len(route) && (unicode.IsLetter(rune(route[i])) || unicode.IsDigit(rune(route[i]))) {
+				i++
+			}
+			tokens = append(tokens, routeToken{routeTokenIdent, route[start:i], start})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i+1)
+		}
+	}
+	return append(tokens, routeToken{kind: routeTokenEOF, pos: len(route)}), nil
+}
+
+type routeParser struct {
+	tokens []routeToken
+	next   int
+}
+
+func (p *routeParser) peek() routeToken {
+	return p.tokens[p.next]
+}
+
+func (p *routeParser) consume() routeToken {
+	t := p.tokens[p.next]
+	if t.kind != routeTokenEOF {
+		p.next++
+	}
+	return t
+}
+
+func (p *routeParser) expect(kind routeTokenKind, what string) (routeToken, error) {
+	t := p.consume()
+	if t.kind != kind {
+		return t, fmt.Errorf("expected %s at position %d, got %s", what, t.pos+1, t)
+	}
+	return t, nil
+}
+
+func (p *routeParser) parseOr() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+	for p.peek().kind == routeTokenOr {
+		p.consume()
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *routeParser) parseAnd() error {
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+	for p.peek().kind == routeTokenAnd {
+		p.consume()
+		if err := p.parseUnary(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *routeParser) parseUnary() error {
+	switch p.peek().kind {
+	case routeTokenNot:
+		p.consume()
+		return p.parseUnary()
+	case routeTokenLParen:
+		p.consume()
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+		_, err := p.expect(routeTokenRParen, "\")\"")
+		return err
+	default:
+		return p.parseMatcher()
+	}
+}
+
+func (p *routeParser) parseMatcher() error {
+	name, err := p.expect(routeTokenIdent, "a matcher")
+	if err != nil {
+		return err
+	}
+	matcher, ok := routeMatchers[name.value]
+	if !ok {
+		return fmt.Errorf("unknown matcher %s at position %d%s", name, name.pos+1, suggestRouteMatcher(name.value))
+	}
+	if _, err := p.expect(routeTokenLParen, fmt.Sprintf("\"(\" after %s", name.value)); err != nil {
+		return err
+	}
+
+	var args []string
+	if p.peek().kind != routeTokenRParen {
+		for {
+			arg, err := p.expect(routeTokenString, "a quoted argument")
+			if err != nil {
+				return err
+			}
+			if matcher.validate != nil {
+				if err := matcher.validate(len(args), arg.value); err != nil {
+					return fmt.Errorf("%s at position %d: %v", name.value, arg.pos+1, err)
+				}
+			}
+			args = append(args, arg.value)
+			if p.peek().kind != routeTokenComma {
+				break
+			}
+			p.consume()
+		}
+	}
+	if _, err := p.expect(routeTokenRParen, "\")\""); err != nil {
+		return err
+	}
+
+	switch {
+	case matcher.maxArgs > 0 && matcher.minArgs == matcher.maxArgs && len(args) != matcher.minArgs:
+		return fmt.Errorf("%s at position %d takes %d arguments, got %d", name.value, name.pos+1, matcher.minArgs, len(args))
+	case len(args) < matcher.minArgs:
+		return fmt.Errorf("%s at position %d takes at least %d argument, got %d", name.value, name.pos+1, matcher.minArgs, len(args))
+	case matcher.maxArgs > 0 && len(args) > matcher.maxArgs:
+		return fmt.Errorf("%s at position %d takes at most %d arguments, got %d", name.value, name.pos+1, matcher.maxArgs, len(args))
+	}
+	return nil
+}
+
+// suggestRouteMatcher returns a hint for a matcher name that only differs
+// from a known one in case, or the list of known matchers otherwise.
+func suggestRouteMatcher(name string) string {
+	names := make([]string, 0, len(routeMatchers))
+	for known := range routeMatchers {
+		if strings.EqualFold(known, name) {
+			return fmt.Sprintf(", did you mean %q?", known)
+		}
+		names = append(names, known)
+	}
+	sort.Strings(names)
+	return fmt.Sprintf(", expected one of %s", strings.Join(names, ", "))
+}
+
+func validateRoutePath(index int, arg string) error {
+	if !strings.HasPrefix(arg, "/") {
+		return fmt.Errorf("path %q must start with \"/\"", arg)
+	}
+	return nil
+}
+
+// validateRouteRegexp returns a validation of the argument at the index as a
+// regular expression.
+func validateRouteRegexp(regexpIndex int) func(int, string) error {
+	return func(index int, arg string) error {
+		if index != regexpIndex {
+			return nil
+		}
+		if _, err := regexp.Compile(arg); err != nil {
+			return fmt.Errorf("invalid regular expression %q: %v", arg, err)
+		}
+		return nil
+	}
+}
+
+func validateRouteMethod(index int, arg string) error {
+	switch strings.ToUpper(arg) {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace:
+		return nil
+	}
+	return fmt.Errorf("unknown HTTP method %q", arg)
+}
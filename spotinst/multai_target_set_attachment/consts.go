@@ -0,0 +1,21 @@
+package multai_target_set_attachment
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	TargetSetID       commons.FieldName = "target_set_id"
+	BalancerID        commons.FieldName = "balancer_id"
+	ElastigroupID     commons.FieldName = "elastigroup_id"
+	ManagedInstanceID commons.FieldName = "managed_instance_id"
+
+	// Computed fields
+	Targets commons.FieldName = "targets"
+)
+
+const (
+	TargetID          commons.FieldName = "id"
+	TargetHost        commons.FieldName = "host"
+	TargetPort        commons.FieldName = "port"
+	TargetWeight      commons.FieldName = "weight"
+	TargetHealthiness commons.FieldName = "healthiness"
+)
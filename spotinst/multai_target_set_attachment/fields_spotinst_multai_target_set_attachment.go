@@ -0,0 +1,198 @@
+package multai_target_set_attachment
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[TargetSetID] = commons.NewGenericField(
+		commons.MultaiTargetSetAttachment,
+		TargetSetID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachmentWrapper := resourceObject.(*commons.MultaiTargetSetAttachmentWrapper)
+			attachment := attachmentWrapper.GetMultaiTargetSetAttachment()
+			if attachment.TargetSetID != nil {
+				if err := resourceData.Set(string(TargetSetID), spotinst.StringValue(attachment.TargetSetID)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TargetSetID), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachmentWrapper := resourceObject.(*commons.MultaiTargetSetAttachmentWrapper)
+			attachment := attachmentWrapper.GetMultaiTargetSetAttachment()
+			attachment.TargetSetID = spotinst.String(resourceData.Get(string(TargetSetID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[BalancerID] = commons.NewGenericField(
+		commons.MultaiTargetSetAttachment,
+		BalancerID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachmentWrapper := resourceObject.(*commons.MultaiTargetSetAttachmentWrapper)
+			attachment := attachmentWrapper.GetMultaiTargetSetAttachment()
+			if attachment.BalancerID != nil {
+				if err := resourceData.Set(string(BalancerID), spotinst.StringValue(attachment.BalancerID)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(BalancerID), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachmentWrapper := resourceObject.(*commons.MultaiTargetSetAttachmentWrapper)
+			attachment := attachmentWrapper.GetMultaiTargetSetAttachment()
+			attachment.BalancerID = spotinst.String(resourceData.Get(string(BalancerID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[ElastigroupID] = commons.NewGenericField(
+		commons.MultaiTargetSetAttachment,
+		ElastigroupID,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{string(ElastigroupID), string(ManagedInstanceID)},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachmentWrapper := resourceObject.(*commons.MultaiTargetSetAttachmentWrapper)
+			attachment := attachmentWrapper.GetMultaiTargetSetAttachment()
+			if attachment.ElastigroupID != nil {
+				if err := resourceData.Set(string(ElastigroupID), spotinst.StringValue(attachment.ElastigroupID)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ElastigroupID), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachmentWrapper := resourceObject.(*commons.MultaiTargetSetAttachmentWrapper)
+			attachment := attachmentWrapper.GetMultaiTargetSetAttachment()
+			if v, ok := resourceData.GetOk(string(ElastigroupID)); ok {
+				attachment.ElastigroupID = spotinst.String(v.(string))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[ManagedInstanceID] = commons.NewGenericField(
+		commons.MultaiTargetSetAttachment,
+		ManagedInstanceID,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{string(ElastigroupID), string(ManagedInstanceID)},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachmentWrapper := resourceObject.(*commons.MultaiTargetSetAttachmentWrapper)
+			attachment := attachmentWrapper.GetMultaiTargetSetAttachment()
+			if attachment.ManagedInstanceID != nil {
+				if err := resourceData.Set(string(ManagedInstanceID), spotinst.StringValue(attachment.ManagedInstanceID)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ManagedInstanceID), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachmentWrapper := resourceObject.(*commons.MultaiTargetSetAttachmentWrapper)
+			attachment := attachmentWrapper.GetMultaiTargetSetAttachment()
+			if v, ok := resourceData.GetOk(string(ManagedInstanceID)); ok {
+				attachment.ManagedInstanceID = spotinst.String(v.(string))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[Targets] = commons.NewGenericField(
+		commons.MultaiTargetSetAttachment,
+		Targets,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(TargetID): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(TargetHost): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(TargetPort): {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+
+					string(TargetWeight): {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+
+					string(TargetHealthiness): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachmentWrapper := resourceObject.(*commons.MultaiTargetSetAttachmentWrapper)
+			attachment := attachmentWrapper.GetMultaiTargetSetAttachment()
+			if err := resourceData.Set(string(Targets), flattenTargets(attachment.Targets)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Targets), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+}
+
+func flattenTargets(targets []*multai.Target) []interface{} {
+	result := make([]interface{}, 0, len(targets))
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+		m := make(map[string]interface{})
+		m[string(TargetID)] = spotinst.StringValue(target.ID)
+		m[string(TargetHost)] = spotinst.StringValue(target.Host)
+		m[string(TargetPort)] = spotinst.IntValue(target.Port)
+		m[string(TargetWeight)] = spotinst.IntValue(target.Weight)
+		if target.Status != nil {
+			m[string(TargetHealthiness)] = spotinst.StringValue(target.Status.Healthiness)
+		}
+		result = append(result, m)
+	}
+	return result
+}
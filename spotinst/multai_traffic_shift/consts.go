@@ -0,0 +1,17 @@
+package multai_traffic_shift
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	FromTargetSetID        commons.FieldName = "from_target_set_id"
+	ToTargetSetID          commons.FieldName = "to_target_set_id"
+	Steps                  commons.FieldName = "steps"
+	StepInterval           commons.FieldName = "step_interval"
+	HealthCheckInterval    commons.FieldName = "health_check_interval"
+	MaxUnhealthyPercentage commons.FieldName = "max_unhealthy_percentage"
+	Rollback               commons.FieldName = "rollback"
+
+	// Computed fields
+	FromWeight commons.FieldName = "from_weight"
+	ToWeight   commons.FieldName = "to_weight"
+)
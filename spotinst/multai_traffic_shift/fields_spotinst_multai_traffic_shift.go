@@ -0,0 +1,259 @@
+package multai_traffic_shift
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[FromTargetSetID] = commons.NewGenericField(
+		commons.MultaiTrafficShift,
+		FromTargetSetID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			if trafficShift.FromTargetSetID != nil {
+				if err := resourceData.Set(string(FromTargetSetID), spotinst.StringValue(trafficShift.FromTargetSetID)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(FromTargetSetID), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			trafficShift.FromTargetSetID = spotinst.String(resourceData.Get(string(FromTargetSetID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[ToTargetSetID] = commons.NewGenericField(
+		commons.MultaiTrafficShift,
+		ToTargetSetID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			if trafficShift.ToTargetSetID != nil {
+				if err := resourceData.Set(string(ToTargetSetID), spotinst.StringValue(trafficShift.ToTargetSetID)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ToTargetSetID), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			trafficShift.ToTargetSetID = spotinst.String(resourceData.Get(string(ToTargetSetID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[Steps] = commons.NewGenericField(
+		commons.MultaiTrafficShift,
+		Steps,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			steps, err := expandSteps(resourceData.Get(string(Steps)))
+			if err != nil {
+				return err
+			}
+			trafficShift.Steps = steps
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			steps, err := expandSteps(resourceData.Get(string(Steps)))
+			if err != nil {
+				return err
+			}
+			trafficShift.Steps = steps
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[StepInterval] = commons.NewGenericField(
+		commons.MultaiTrafficShift,
+		StepInterval,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      300,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			trafficShift.StepInterval = spotinst.Int(resourceData.Get(string(StepInterval)).(int))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			trafficShift.StepInterval = spotinst.Int(resourceData.Get(string(StepInterval)).(int))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[HealthCheckInterval] = commons.NewGenericField(
+		commons.MultaiTrafficShift,
+		HealthCheckInterval,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      30,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			trafficShift.HealthCheckInterval = spotinst.Int(resourceData.Get(string(HealthCheckInterval)).(int))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			trafficShift.HealthCheckInterval = spotinst.Int(resourceData.Get(string(HealthCheckInterval)).(int))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[MaxUnhealthyPercentage] = commons.NewGenericField(
+		commons.MultaiTrafficShift,
+		MaxUnhealthyPercentage,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			trafficShift.MaxUnhealthyPercentage = spotinst.Int(resourceData.Get(string(MaxUnhealthyPercentage)).(int))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			trafficShift.MaxUnhealthyPercentage = spotinst.Int(resourceData.Get(string(MaxUnhealthyPercentage)).(int))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Rollback] = commons.NewGenericField(
+		commons.MultaiTrafficShift,
+		Rollback,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			trafficShift.Rollback = spotinst.Bool(resourceData.Get(string(Rollback)).(bool))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			trafficShift.Rollback = spotinst.Bool(resourceData.Get(string(Rollback)).(bool))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[FromWeight] = commons.NewGenericField(
+		commons.MultaiTrafficShift,
+		FromWeight,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			if err := resourceData.Set(string(FromWeight), spotinst.IntValue(trafficShift.FromWeight)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(FromWeight), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[ToWeight] = commons.NewGenericField(
+		commons.MultaiTrafficShift,
+		ToWeight,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			trafficShiftWrapper := resourceObject.(*commons.MultaiTrafficShiftWrapper)
+			trafficShift := trafficShiftWrapper.GetMultaiTrafficShift()
+			if err := resourceData.Set(string(ToWeight), spotinst.IntValue(trafficShift.ToWeight)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ToWeight), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+}
+
+// expandSteps returns the steps of the shift, which must not decrease.
+func expandSteps(data interface{}) ([]int, error) {
+	list := data.([]interface{})
+	steps := make([]int, 0, len(list))
+	for i, v := range list {
+		step := v.(int)
+		if i > 0 && step < steps[i-1] {
+			return nil, fmt.Errorf("invalid %s: step %d (%d%%) is lower than the step before it (%d%%)",
+				string(Steps), i+1, step, steps[i-1])
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
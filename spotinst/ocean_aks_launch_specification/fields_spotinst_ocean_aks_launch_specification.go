@@ -1,7 +1,6 @@
 package ocean_aks_launch_specification
 
 import (
-	"encoding/base64"
 	"errors"
 	"fmt"
 
@@ -71,7 +70,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 				}
 				return false
 			},
-			StateFunc: Base64StateFunc,
+			StateFunc: commons.Base64StateFunc,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			clusterWrapper := resourceObject.(*commons.AKSClusterWrapper)
@@ -89,7 +88,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			clusterWrapper := resourceObject.(*commons.AKSClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
 			if v, ok := resourceData.Get(string(CustomData)).(string); ok && v != "" {
-				customData := spotinst.String(base64Encode(v))
+				customData := spotinst.String(commons.Base64Encode(v))
 				cluster.VirtualNodeGroupTemplate.LaunchSpecification.SetCustomData(customData)
 			}
 			return nil
@@ -99,7 +98,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			cluster := clusterWrapper.GetCluster()
 			var value *string = nil
 			if v, ok := resourceData.Get(string(CustomData)).(string); ok && v != "" {
-				customData := spotinst.String(base64Encode(v))
+				customData := spotinst.String(commons.Base64Encode(v))
 				value = customData
 			}
 			cluster.VirtualNodeGroupTemplate.LaunchSpecification.SetCustomData(value)
@@ -240,31 +239,6 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 
 }
 
-func Base64StateFunc(v interface{}) string {
-	if isBase64Encoded(v.(string)) {
-		return v.(string)
-	} else {
-		return base64Encode(v.(string))
-	}
-}
-
-// base64Encode encodes data if the input isn't already encoded using
-// base64.StdEncoding.EncodeToString. If the input is already base64 encoded,
-// return the original input unchanged.
-func base64Encode(data string) string {
-	// Check whether the data is already Base64 encoded; don't double-encode
-	if isBase64Encoded(data) {
-		return data
-	}
-	// data has not been encoded encode and return
-	return base64.StdEncoding.EncodeToString([]byte(data))
-}
-
-func isBase64Encoded(data string) bool {
-	_, err := base64.StdEncoding.DecodeString(data)
-	return err == nil
-}
-
 func expandTags(data interface{}) ([]*azure.Tag, error) {
 	list := data.(*schema.Set).List()
 	tags := make([]*azure.Tag, 0, len(list))
@@ -7,6 +7,7 @@ const (
 	IAMInstanceProfile       commons.FieldName = "iam_instance_profile"
 	KeyName                  commons.FieldName = "key_name"
 	UserData                 commons.FieldName = "user_data"
+	UserDataBase64           commons.FieldName = "user_data_base64"
 	SecurityGroups           commons.FieldName = "security_groups"
 	AssociatePublicIpAddress commons.FieldName = "associate_public_ip_address"
 	LoadBalancers            commons.FieldName = "load_balancers"
@@ -1,7 +1,6 @@
 package ocean_aws_launch_configuration
 
 import (
-	"encoding/base64"
 	"fmt"
 	"regexp"
 
@@ -244,47 +243,45 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 	fieldsMap[UserData] = commons.NewGenericField(
 		commons.OceanAWSLaunchConfiguration,
 		UserData,
-		&schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
-			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-				// Sometimes the EC2 API responds with the equivalent, empty SHA1 sum
-				if (old == "da39a3ee5e6b4b0d3255bfef95601890afd80709" && new == "") ||
-					(old == "" && new == "da39a3ee5e6b4b0d3255bfef95601890afd80709") {
-					return true
-				}
-				return false
-			},
-			StateFunc: Base64StateFunc,
-		},
+		commons.UserDataSchema(UserDataBase64),
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
-			var value = ""
-			if cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil &&
-				cluster.Compute.LaunchSpecification.UserData != nil {
-
-				userData := cluster.Compute.LaunchSpecification.UserData
-				userDataValue := spotinst.StringValue(userData)
-				if userDataValue != "" {
-					if isBase64Encoded(resourceData.Get(string(UserData)).(string)) {
-						value = userDataValue
-					} else {
-						decodedUserData, _ := base64.StdEncoding.DecodeString(userDataValue)
-						value = string(decodedUserData)
-					}
-				}
+			var userData *string
+			if cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil {
+				userData = cluster.Compute.LaunchSpecification.UserData
 			}
-			if err := resourceData.Set(string(UserData), Base64StateFunc(value)); err != nil {
-				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(UserData), err)
+			return commons.FlattenUserData(resourceData, UserData, UserDataBase64, userData)
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			if userData := commons.ExpandUserData(resourceData, UserData, UserDataBase64); userData != nil {
+				cluster.Compute.LaunchSpecification.SetUserData(userData)
 			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
-			if v, ok := resourceData.Get(string(UserData)).(string); ok && v != "" {
-				userData := spotinst.String(base64Encode(v))
+			cluster.Compute.LaunchSpecification.SetUserData(commons.ExpandUserData(resourceData, UserData, UserDataBase64))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[UserDataBase64] = commons.NewGenericField(
+		commons.OceanAWSLaunchConfiguration,
+		UserDataBase64,
+		commons.UserDataBase64Schema(UserData),
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			// Read along with the user_data field.
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			if userData := commons.ExpandUserData(resourceData, UserData, UserDataBase64); userData != nil {
 				cluster.Compute.LaunchSpecification.SetUserData(userData)
 			}
 			return nil
@@ -292,11 +289,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
-			var userData *string = nil
-			if v, ok := resourceData.Get(string(UserData)).(string); ok && v != "" {
-				userData = spotinst.String(base64Encode(v))
-			}
-			cluster.Compute.LaunchSpecification.SetUserData(userData)
+			cluster.Compute.LaunchSpecification.SetUserData(commons.ExpandUserData(resourceData, UserData, UserDataBase64))
 			return nil
 		},
 		nil,
@@ -627,31 +620,6 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 
 var InstanceProfileArnRegex = regexp.MustCompile(`arn:aws:iam::\d{12}:instance-profile/?[a-zA-Z_0-9+=,.@\-_/]+`)
 
-func Base64StateFunc(v interface{}) string {
-	if isBase64Encoded(v.(string)) {
-		return v.(string)
-	} else {
-		return base64Encode(v.(string))
-	}
-}
-
-// base64Encode encodes data if the input isn't already encoded using
-// base64.StdEncoding.EncodeToString. If the input is already base64 encoded,
-// return the original input unchanged.
-func base64Encode(data string) string {
-	// Check whether the data is already Base64 encoded; don't double-encode
-	if isBase64Encoded(data) {
-		return data
-	}
-	// data has not been encoded encode and return
-	return base64.StdEncoding.EncodeToString([]byte(data))
-}
-
-func isBase64Encoded(data string) bool {
-	_, err := base64.StdEncoding.DecodeString(data)
-	return err == nil
-}
-
 func expandLb(lb interface{}) ([]*aws.LoadBalancer, error) {
 	list := lb.([]interface{})
 	lbOutput := make([]*aws.LoadBalancer, 0, len(list))
@@ -36,6 +36,7 @@ const (
 	OceanID                  commons.FieldName = "ocean_id"
 	ImageID                  commons.FieldName = "image_id"
 	UserData                 commons.FieldName = "user_data"
+	UserDataBase64           commons.FieldName = "user_data_base64"
 	IamInstanceProfile       commons.FieldName = "iam_instance_profile"
 	Labels                   commons.FieldName = "labels"
 	Taints                   commons.FieldName = "taints"
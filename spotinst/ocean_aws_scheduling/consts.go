@@ -6,9 +6,11 @@ const (
 	ScheduledTask          commons.FieldName = "scheduled_task"
 	ShutdownHours          commons.FieldName = "shutdown_hours"
 	TimeWindows            commons.FieldName = "time_windows"
+	WeeklyOffHours         commons.FieldName = "weekly_off_hours"
 	ShutdownHoursIsEnabled commons.FieldName = "is_enabled"
 	Tasks                  commons.FieldName = "tasks"
 	TasksIsEnabled         commons.FieldName = "is_enabled"
 	CronExpression         commons.FieldName = "cron_expression"
+	NextExecutions         commons.FieldName = "next_executions"
 	TaskType               commons.FieldName = "task_type"
 )
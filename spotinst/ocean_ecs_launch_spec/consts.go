@@ -17,6 +17,7 @@ const (
 	ImageID            commons.FieldName = "image_id"
 	IamInstanceProfile commons.FieldName = "iam_instance_profile"
 	UserData           commons.FieldName = "user_data"
+	UserDataBase64     commons.FieldName = "user_data_base64"
 	SecurityGroupIds   commons.FieldName = "security_group_ids"
 	Name               commons.FieldName = "name"
 	Attributes         commons.FieldName = "attributes"
@@ -7,6 +7,7 @@ const (
 	IamInstanceProfile       commons.FieldName = "iam_instance_profile"
 	KeyPair                  commons.FieldName = "key_pair"
 	UserData                 commons.FieldName = "user_data"
+	UserDataBase64           commons.FieldName = "user_data_base64"
 	AssociatePublicIpAddress commons.FieldName = "associate_public_ip_address"
 	ImageID                  commons.FieldName = "image_id"
 	Monitoring               commons.FieldName = "monitoring"
@@ -35,9 +35,12 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								},
 
 								string(CronExpression): {
-									Type:     schema.TypeString,
-									Required: true,
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: commons.ValidateCronExpression,
 								},
+
+								string(NextExecutions): commons.NextExecutionsSchema(),
 							},
 						},
 					},
@@ -52,10 +55,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Optional: true,
 								},
 
-								string(TimeWindows): {
-									Type:     schema.TypeList,
-									Required: true,
-									Elem:     &schema.Schema{Type: schema.TypeString},
+								string(TimeWindows): commons.TimeWindowsSchema(true),
+
+								string(WeeklyOffHours): {
+									Type:     schema.TypeFloat,
+									Computed: true,
 								},
 							},
 						},
@@ -122,15 +126,9 @@ func expandShutdownHours(data interface{}) (*aws.ECSShutdownHours, error) {
 		}
 		runner.SetIsEnabled(isEnabled)
 
-		var timeWindows []string = nil
-		if v, ok := m[string(TimeWindows)].([]interface{}); ok && len(v) > 0 {
-			timeWindowList := make([]string, 0, len(v))
-			for _, timeWindow := range v {
-				if v, ok := timeWindow.(string); ok && len(v) > 0 {
-					timeWindowList = append(timeWindowList, v)
-				}
-			}
-			timeWindows = timeWindowList
+		timeWindows, err := commons.ExpandTimeWindows(m[string(TimeWindows)])
+		if err != nil {
+			return nil, err
 		}
 		runner.SetTimeWindows(timeWindows)
 
@@ -169,6 +167,7 @@ func flattenShutdownHours(shutdownHours *aws.ECSShutdownHours) []interface{} {
 	if shutdownHours.TimeWindows != nil {
 		result[string(TimeWindows)] = shutdownHours.TimeWindows
 	}
+	result[string(WeeklyOffHours)] = commons.TimeWindowsWeeklyHours(shutdownHours.TimeWindows)
 
 	return []interface{}{result}
 }
@@ -181,6 +180,7 @@ func flattenTasks(tasks []*aws.ECSTask) []interface{} {
 		m[string(TasksIsEnabled)] = spotinst.BoolValue(task.IsEnabled)
 		m[string(TaskType)] = spotinst.StringValue(task.Type)
 		m[string(CronExpression)] = spotinst.StringValue(task.CronExpression)
+		m[string(NextExecutions)] = commons.CronNextExecutions(spotinst.StringValue(task.CronExpression))
 		result = append(result, m)
 	}
 
@@ -243,3 +243,25 @@ func expandtasks(data interface{}) ([]*aws.ECSTask, error) {
 
 	return tasks, nil
 }
+
+// ValidateTimeWindows is a schema.CustomizeDiffFunc that rejects malformed,
+// inverted or overlapping shutdown hours time windows at plan time.
+func ValidateTimeWindows(diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk(string(ScheduledTask))
+	if !ok {
+		return nil
+	}
+	for _, item := range v.(*schema.Set).List() {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if list, ok := m[string(ShutdownHours)].([]interface{}); ok && len(list) > 0 && list[0] != nil {
+			shutdownHours := list[0].(map[string]interface{})
+			if _, err := commons.ExpandTimeWindows(shutdownHours[string(TimeWindows)]); err != nil {
+				return fmt.Errorf("%s.%s.%s: %v", string(ScheduledTask), string(ShutdownHours), string(TimeWindows), err)
+			}
+		}
+	}
+	return nil
+}
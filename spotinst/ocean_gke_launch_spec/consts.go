@@ -47,4 +47,8 @@ const (
 
 const (
 	NodePoolName commons.FieldName = "node_pool_name"
+
+	// Imported is set by the importer, node_pool_name is not compared against
+	// imported launch specs.
+	Imported commons.FieldName = "imported"
 )
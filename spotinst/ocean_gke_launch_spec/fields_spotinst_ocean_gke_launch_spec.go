@@ -58,6 +58,13 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Type:     schema.TypeString,
 			Optional: true,
 			ForceNew: true,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				// The node pool is only used to seed the launch spec on creation and is
+				// never returned by the API, so an imported launch spec has no value to
+				// compare against.
+				imported, _ := d.Get(string(Imported)).(bool)
+				return old == "" && imported
+			},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			return nil
@@ -73,6 +80,19 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[Imported] = commons.NewGenericField(
+		commons.OceanGKELaunchSpec,
+		Imported,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
 	fieldsMap[SourceImage] = commons.NewGenericField(
 		commons.OceanGKELaunchSpec,
 		SourceImage,
@@ -11,8 +11,9 @@ func Provider() terraform.ResourceProvider {
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			string(commons.ProviderToken): {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
 				//DefaultFunc: schema.EnvDefaultFunc(credentials.EnvCredentialsVarToken, ""),
 				Description: "Spotinst Personal API Access Token",
 			},
@@ -55,21 +56,52 @@ func Provider() terraform.ResourceProvider {
 			string(commons.OceanAKSVirtualNodeGroupResourceName): resourceSpotinstOceanAKSVirtualNodeGroup(),
 
 			// Multai.
-			string(commons.MultaiBalancerResourceName):    resourceSpotinstMultaiBalancer(),
-			string(commons.MultaiDeploymentResourceName):  resourceSpotinstMultaiDeployment(),
-			string(commons.MultaiListenerResourceName):    resourceSpotinstMultaiListener(),
-			string(commons.MultaiRoutingRuleResourceName): resourceSpotinstMultaiRoutingRule(),
-			string(commons.MultaiTargetResourceName):      resourceSpotinstMultaiTarget(),
-			string(commons.MultaiTargetSetResourceName):   resourceSpotinstMultaiTargetSet(),
+			string(commons.MultaiBalancerResourceName):            resourceSpotinstMultaiBalancer(),
+			string(commons.MultaiCertificateResourceName):         resourceSpotinstMultaiCertificate(),
+			string(commons.MultaiDeploymentResourceName):          resourceSpotinstMultaiDeployment(),
+			string(commons.MultaiListenerResourceName):            resourceSpotinstMultaiListener(),
+			string(commons.MultaiMiddlewareResourceName):          resourceSpotinstMultaiMiddleware(),
+			string(commons.MultaiRoutingRuleResourceName):         resourceSpotinstMultaiRoutingRule(),
+			string(commons.MultaiTargetResourceName):              resourceSpotinstMultaiTarget(),
+			string(commons.MultaiTargetSetResourceName):           resourceSpotinstMultaiTargetSet(),
+			string(commons.MultaiTargetSetAttachmentResourceName): resourceSpotinstMultaiTargetSetAttachment(),
+			string(commons.MultaiTrafficShiftResourceName):        resourceSpotinstMultaiTrafficShift(),
 
 			// Managed Instance.
-			string(commons.ManagedInstanceAWSResourceName): resourceSpotinstMangedInstanceAWS(),
+			string(commons.ManagedInstanceAWSResourceName):   resourceSpotinstMangedInstanceAWS(),
+			string(commons.ManagedInstanceStateResourceName): resourceSpotinstManagedInstanceState(),
 
 			// HealthCheck
 			string(commons.HealthCheckResourceName): resourceSpotinstHealthCheck(),
 
 			// SuspendProcesses
 			string(commons.SuspendProcessesResourceName): resourceSpotinstElastigroupSuspendProcesses(),
+
+			// ScalingPolicy
+			string(commons.ElastigroupAWSScalingPolicyResourceName): resourceSpotinstElastigroupAWSScalingPolicy(),
+
+			// ScheduledTask
+			string(commons.ElastigroupAWSScheduledTaskResourceName): resourceSpotinstElastigroupAWSScheduledTask(),
+
+			// StatefulInstanceState
+			string(commons.ElastigroupAWSStatefulInstanceStateResourceName): resourceSpotinstElastigroupAWSStatefulInstanceState(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			// Elastigroup.
+			string(commons.ElastigroupAWSStatefulInstancesDataSourceName): dataSourceSpotinstElastigroupAWSStatefulInstances(),
+
+			// Health Check.
+			string(commons.HealthChecksDataSourceName): dataSourceSpotinstHealthChecks(),
+
+			// MrScaler.
+			string(commons.MRScalerAWSDataSourceName): dataSourceSpotinstMRScalerAWS(),
+
+			// Multai.
+			string(commons.MultaiBalancerDataSourceName):   dataSourceSpotinstMultaiBalancer(),
+			string(commons.MultaiDeploymentDataSourceName): dataSourceSpotinstMultaiDeployment(),
+			string(commons.MultaiListenersDataSourceName):  dataSourceSpotinstMultaiListeners(),
+			string(commons.MultaiTargetSetsDataSourceName): dataSourceSpotinstMultaiTargetSets(),
 		},
 	}
 
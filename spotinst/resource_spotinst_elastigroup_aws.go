@@ -20,6 +20,7 @@ import (
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_launch_configuration"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_network_interface"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_scaling_policies"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_scaling_policy"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_scheduled_task"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_stateful"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_strategy"
@@ -39,6 +40,8 @@ func resourceSpotinstElastigroupAWS() *schema.Resource {
 		},
 
 		Schema: commons.ElastigroupResource.GetSchemaMap(),
+
+		CustomizeDiff: elastigroup_aws.ValidateRevertToSpot,
 	}
 }
 
@@ -244,15 +247,25 @@ func resourceSpotinstElastigroupAWSUpdate(resourceData *schema.ResourceData, met
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.ElastigroupResource.GetName(), id)
 
-	shouldUpdate, elastigroup, err := commons.ElastigroupResource.OnUpdate(resourceData, meta)
+	changeset, elastigroup, err := commons.ElastigroupResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		elastigroup.SetId(spotinst.String(id))
+
+		if ignore, ok := resourceData.Get(string(elastigroup_aws_scaling_policies.IgnoreExternalScalingPolicies)).(bool); ok && ignore {
+			elastigroupAWSMutexKV.Lock(id)
+			defer elastigroupAWSMutexKV.Unlock(id)
+
+			if err := keepExternalScalingPolicies(elastigroup, resourceData, meta); err != nil {
+				return err
+			}
+		}
+
 		if err := updateGroup(elastigroup, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
@@ -357,6 +370,58 @@ func updateGroup(elastigroup *aws.Group, resourceData *schema.ResourceData, meta
 	return nil
 }
 
+// keepExternalScalingPolicies adds the policies that are attached to the group
+// but not configured on it back into the updated policy lists, so that an
+// update of the inline policies does not remove externally managed ones.
+func keepExternalScalingPolicies(elastigroup *aws.Group, resourceData *schema.ResourceData, meta interface{}) error {
+	policyFields := []struct {
+		policyType string
+		fieldName  commons.FieldName
+	}{
+		{elastigroup_aws_scaling_policy.PolicyTypeUp, elastigroup_aws_scaling_policies.ScalingUpPolicy},
+		{elastigroup_aws_scaling_policy.PolicyTypeDown, elastigroup_aws_scaling_policies.ScalingDownPolicy},
+		{elastigroup_aws_scaling_policy.PolicyTypeTarget, elastigroup_aws_scaling_policies.ScalingTargetPolicy},
+	}
+
+	var current *aws.Group
+	for _, policyField := range policyFields {
+		if !resourceData.HasChange(string(policyField.fieldName)) {
+			continue
+		}
+
+		if current == nil {
+			group, err := readElastigroupAWS(resourceData.Id(), meta.(*Client))
+			if err != nil {
+				return err
+			}
+			if group == nil {
+				return nil
+			}
+			current = group
+		}
+
+		// Policies that were removed from the configuration are still managed
+		// by the group, and should not be kept.
+		o, n := resourceData.GetChange(string(policyField.fieldName))
+		managed := elastigroup_aws_scaling_policies.ScalingPolicyNames(o)
+		for name := range elastigroup_aws_scaling_policies.ScalingPolicyNames(n) {
+			managed[name] = true
+		}
+
+		policies := getGroupScalingPolicies(elastigroup.Scaling, policyField.policyType)
+		for _, policy := range getGroupScalingPolicies(current.Scaling, policyField.policyType) {
+			if !managed[spotinst.StringValue(policy.PolicyName)] {
+				policies = append(policies, policy)
+			}
+		}
+
+		if err := setGroupScalingPolicies(elastigroup.Scaling, policyField.policyType, policies); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func checkStatefulActionUniqueness(actionList []interface{}) error {
 	seenIDs := make(map[string]struct{})
 	for _, action := range actionList {
@@ -30,7 +30,37 @@ func resourceSpotinstElastigroupAWSBeanstalk() *schema.Resource {
 		},
 
 		Schema: commons.ElastigroupAWSBeanstalkResource.GetSchemaMap(),
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceSpotinstElastigroupAWSBeanstalkV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeElastigroupAWSBeanstalkStateV0,
+			},
+		},
+	}
+}
+
+// resourceSpotinstElastigroupAWSBeanstalkV0 is the schema of version 0, in
+// which maintenance was a string holding the maintenance operation to run.
+func resourceSpotinstElastigroupAWSBeanstalkV0() *schema.Resource {
+	s := make(map[string]*schema.Schema)
+	for k, v := range commons.ElastigroupAWSBeanstalkResource.GetSchemaMap() {
+		s[k] = v
 	}
+	s[string(elastigroup_aws_beanstalk.Maintenance)] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	return &schema.Resource{Schema: s}
+}
+
+// upgradeElastigroupAWSBeanstalkStateV0 drops the maintenance operation, the
+// maintenance block is read from the group.
+func upgradeElastigroupAWSBeanstalkStateV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	delete(rawState, string(elastigroup_aws_beanstalk.Maintenance))
+	return rawState, nil
 }
 
 func setupElastigroupAWSBeanstalk() {
@@ -76,58 +106,157 @@ func importBeanstalkGroup(resourceData *schema.ResourceData, meta interface{}) (
 	return resp.Group, err
 }
 
-func toggleMaintenanceMode(resourceData *schema.ResourceData, meta interface{}, op string) error {
-	id := resourceData.Id()
+// convergeBeanstalkMaintenance starts or finishes the Beanstalk maintenance of
+// the group, and waits until the group awaits the user update or is active
+// again. A group that is between statuses is waited for before maintenance is
+// started or finished. Finishing maintenance deploys the platform update to
+// the group, which is waited for as well.
+func convergeBeanstalkMaintenance(id string, enabled bool, timeout int, meta interface{}) error {
+	svc := meta.(*Client).elastigroup.CloudProviderAWS()
+	input := &aws.BeanstalkMaintenanceInput{GroupID: spotinst.String(id)}
+	deadline := time.Now().Add(time.Second * time.Duration(timeout))
+
+	desired, current := elastigroup_aws_beanstalk.MaintenanceStatusActive, elastigroup_aws_beanstalk.MaintenanceStatusAwaitUserUpdate
+	if enabled {
+		desired, current = current, desired
+	}
+	requested := false
 
-	err := resource.Retry(time.Minute, func() *resource.RetryError {
-		input := &aws.BeanstalkMaintenanceInput{GroupID: spotinst.String(id)}
-		if status, err := meta.(*Client).elastigroup.CloudProviderAWS().GetBeanstalkMaintenanceStatus(context.Background(), input); err == nil {
-			if op == "START" {
-				if *status == "AWAIT_USER_UPDATE" {
-					err = fmt.Errorf("===> Unable to start maintenance, already in maintenance mode")
-					return resource.NonRetryableError(err)
-				} else if *status == "ACTIVE" {
-					_, err := meta.(*Client).elastigroup.CloudProviderAWS().StartBeanstalkMaintenance(context.Background(), input)
-					if err != nil {
-						return resource.NonRetryableError(err)
-					}
-					log.Printf("===> Sending request to begin Beanstalk Maintenance Mode <===")
-				} else {
-					err = fmt.Errorf("===> Unable to start maintenance, group status is: %s <===", *status)
-					return resource.RetryableError(err)
-				}
-				return nil
-			} else if op == "END" {
-				if *status == "ACTIVE" {
-					err = fmt.Errorf("===> Unable to end maintenance, your beanstalk elastigroup is already active")
+	// Deployments that exist before maintenance is finished, so that the one
+	// started by finishing it can be told apart.
+	var knownDeployments map[string]bool
+
+	err := resource.Retry(time.Until(deadline), func() *resource.RetryError {
+		status, err := readBeanstalkMaintenanceStatus(id, meta)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if spotinst.StringValue(status) == desired {
+			return nil
+		}
+
+		if spotinst.StringValue(status) == current && !requested {
+			if enabled {
+				log.Printf("===> Sending request to begin Beanstalk Maintenance Mode <===")
+				_, err = svc.StartBeanstalkMaintenance(context.Background(), input)
+			} else {
+				if knownDeployments, err = listBeanstalkDeploymentIDs(id, meta); err != nil {
 					return resource.NonRetryableError(err)
-				} else if *status == "AWAIT_USER_UPDATE" {
-					_, err := meta.(*Client).elastigroup.CloudProviderAWS().FinishBeanstalkMaintenance(context.Background(), input)
-					if err != nil {
-						return resource.NonRetryableError(err)
-					}
-					log.Printf("===> Sending request to end Beanstalk Maintenance Mode <===")
-				} else {
-					err = fmt.Errorf("===> Unable to end Maintenance state, group status is: %s <===", *status)
-					return resource.RetryableError(err)
 				}
+				log.Printf("===> Sending request to end Beanstalk Maintenance Mode <===")
+				_, err = svc.FinishBeanstalkMaintenance(context.Background(), input)
+			}
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			requested = true
+		}
+
+		return resource.RetryableError(fmt.Errorf("===> Beanstalk Maintenance Status is %s, waiting for %s <===",
+			spotinst.StringValue(status), desired))
+	})
+
+	if err != nil {
+		return fmt.Errorf("BEANSTALK:MaintenanceMode failed to resolve Maintenance Mode %s", err)
+	}
+
+	if knownDeployments != nil {
+		return awaitBeanstalkPlatformUpdateDeployment(id, knownDeployments, deadline, meta)
+	}
+	return nil
+}
+
+// awaitBeanstalkPlatformUpdateDeployment waits for the deployment started by
+// finishing maintenance, i.e. a deployment of the group that is not one of the
+// known deployments, to finish. Finishing maintenance without a platform update
+// to deploy does not start a deployment, so there is nothing to wait for.
+func awaitBeanstalkPlatformUpdateDeployment(id string, knownDeployments map[string]bool, deadline time.Time, meta interface{}) error {
+	err := resource.Retry(time.Until(deadline), func() *resource.RetryError {
+		deployments, err := listBeanstalkDeployments(id, meta)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		for _, deployment := range deployments {
+			deploymentID := spotinst.StringValue(deployment.RollID)
+			if knownDeployments[deploymentID] {
+				continue
+			}
+
+			status := strings.ToUpper(spotinst.StringValue(deployment.RollStatus))
+			log.Printf("===> Beanstalk platform update deployment %s status: %s <===", deploymentID, status)
+
+			switch status {
+			case "FINISHED":
 				return nil
-			} else if op == "STATUS" {
-				log.Printf("===> Beanstalk Maintenance Status: %s <===", *status)
-				return nil
+			case "FAILED", "STOPPED":
+				return resource.NonRetryableError(fmt.Errorf("deployment %s is %s", deploymentID, status))
+			default:
+				return resource.RetryableError(fmt.Errorf("===> Deployment %s is %s, waiting for it to finish <===",
+					deploymentID, status))
 			}
 		}
+
+		log.Printf("===> No Beanstalk platform update deployment was started for group %s <===", id)
 		return nil
 	})
 
 	if err != nil {
-		return fmt.Errorf("BEANSTALK:MaintenanceMode failed to resolve Maintenance Mode %s", err)
+		return fmt.Errorf("BEANSTALK:MaintenanceMode failed to deploy the platform update: %s", err)
 	}
 	return nil
 }
 
+// listBeanstalkDeployments lists the deployments of the group. DeploymentStatus
+// reads a single deployment by its ID, without an ID it reads them all.
+func listBeanstalkDeployments(id string, meta interface{}) ([]*aws.RollGroupStatus, error) {
+	input := &aws.DeploymentStatusInput{GroupID: spotinst.String(id)}
+	resp, err := meta.(*Client).elastigroup.CloudProviderAWS().DeploymentStatus(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("BEANSTALK:MaintenanceMode failed to list deployments: %s", err)
+	}
+	return resp.RollGroupStatus, nil
+}
+
+func listBeanstalkDeploymentIDs(id string, meta interface{}) (map[string]bool, error) {
+	deployments, err := listBeanstalkDeployments(id, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]bool, len(deployments))
+	for _, deployment := range deployments {
+		ids[spotinst.StringValue(deployment.RollID)] = true
+	}
+	return ids, nil
+}
+
+func readBeanstalkMaintenanceStatus(id string, meta interface{}) (*string, error) {
+	input := &aws.BeanstalkMaintenanceInput{GroupID: spotinst.String(id)}
+	status, err := meta.(*Client).elastigroup.CloudProviderAWS().GetBeanstalkMaintenanceStatus(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("BEANSTALK:MaintenanceMode failed to read Maintenance Status: %s", err)
+	}
+	log.Printf("===> Beanstalk Maintenance Status: %s <===", spotinst.StringValue(status))
+	return status, nil
+}
+
+// beanstalkMaintenanceConfig returns the configured maintenance, and whether it
+// is configured at all.
+func beanstalkMaintenanceConfig(resourceData *schema.ResourceData) (bool, int, bool) {
+	if v, ok := resourceData.GetOk(string(elastigroup_aws_beanstalk.Maintenance)); ok {
+		if list := v.([]interface{}); len(list) > 0 && list[0] != nil {
+			m := list[0].(map[string]interface{})
+			return m[string(elastigroup_aws_beanstalk.MaintenanceEnabled)].(bool),
+				m[string(elastigroup_aws_beanstalk.MaintenanceTimeout)].(int), true
+		}
+	}
+	return false, 0, false
+}
+
 func resourceSpotinstAWSBeanstalkGroupCreate(resourceData *schema.ResourceData, meta interface{}) error {
-	log.Print(string(commons.ResourceOnCreate),
+	log.Printf(string(commons.ResourceOnCreate),
 		commons.ElastigroupAWSBeanstalkResource.GetName())
 
 	beanstalkGroup, err := importBeanstalkGroup(resourceData, meta.(*Client))
@@ -151,6 +280,13 @@ func resourceSpotinstAWSBeanstalkGroupCreate(resourceData *schema.ResourceData,
 
 	resourceData.SetId(spotinst.StringValue(groupId))
 	log.Printf("===> AWSBeanstalkGroup created successfully: %s <===", resourceData.Id())
+
+	if enabled, timeout, ok := beanstalkMaintenanceConfig(resourceData); ok && enabled {
+		if err := convergeBeanstalkMaintenance(resourceData.Id(), enabled, timeout, meta); err != nil {
+			return err
+		}
+	}
+
 	return resourceSpotinstAWSBeanstalkGroupRead(resourceData, meta)
 }
 
@@ -218,7 +354,14 @@ func resourceSpotinstAWSBeanstalkGroupRead(resourceData *schema.ResourceData, me
 		return nil
 	}
 
-	if err := commons.ElastigroupAWSBeanstalkResource.OnRead(groupResponse, resourceData, meta); err != nil {
+	// The maintenance status is read on a best-effort basis, maintenance.status
+	// is left unchanged when it cannot be read.
+	maintenanceStatus, err := readBeanstalkMaintenanceStatus(id, meta)
+	if err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+
+	if err := commons.ElastigroupAWSBeanstalkResource.OnRead(groupResponse, maintenanceStatus, resourceData, meta); err != nil {
 		return err
 	}
 
@@ -231,24 +374,23 @@ func resourceSpotinstAWSBeanstalkGroupUpdate(resourceData *schema.ResourceData,
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.ElastigroupAWSBeanstalkResource.GetName(), id)
 
-	shouldUpdate, elastigroupBeanstalk, err := commons.ElastigroupAWSBeanstalkResource.OnUpdate(resourceData, meta)
+	changeset, elastigroupBeanstalk, err := commons.ElastigroupAWSBeanstalkResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	maint, err := commons.ElastigroupAWSBeanstalkResource.MaintenanceState(resourceData, meta)
-	if err != nil {
-		return err
+	if resourceData.HasChange(string(elastigroup_aws_beanstalk.Maintenance)) {
+		if enabled, timeout, ok := beanstalkMaintenanceConfig(resourceData); ok {
+			if err := convergeBeanstalkMaintenance(id, enabled, timeout, meta); err != nil {
+				return err
+			}
+		}
 	}
 
-	maintErr := toggleMaintenanceMode(resourceData, meta, maint)
-	if maintErr != nil {
-		return maintErr
-	}
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		elastigroupBeanstalk.SetId(spotinst.String(id))
 		if err := updateGroup(elastigroupBeanstalk, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
@@ -343,6 +343,91 @@ resource "` + string(commons.ElastigroupAWSBeanstalkResourceName) + `" "%v" {
 
 `
 
+// region Beanstalk Elastigroup: Maintenance
+func TestAccSpotinstElastigroupAWSBeanstalk_Maintenance(t *testing.T) {
+	groupName := "test-acc-bs-maintenance"
+	resourceName := createElastigroupAWSBeanstalkResourceName(groupName)
+
+	var group aws.Group
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testElastigroupAWSBeanstalkDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createElastigroupAWSBeanstalkTerraform(&BeanstalkGroupConfigMetadata{groupName: groupName}, testMaintenanceBeanstalkGroupConfig_Update, testMaintenanceBeanstalkGroupConfig_Create),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAWSBeanstalkExists(&group, resourceName),
+					testCheckElastigroupAWSBeanstalkAttributes(&group, groupName),
+					resource.TestCheckResourceAttr(resourceName, "maintenance.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance.0.timeout", "1800"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance.0.status", "AWAIT_USER_UPDATE"),
+				),
+			},
+			{
+				Config: createElastigroupAWSBeanstalkTerraform(&BeanstalkGroupConfigMetadata{groupName: groupName, updateBaselineFields: true}, testMaintenanceBeanstalkGroupConfig_Update, testMaintenanceBeanstalkGroupConfig_Create),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAWSBeanstalkExists(&group, resourceName),
+					testCheckElastigroupAWSBeanstalkAttributes(&group, groupName),
+					resource.TestCheckResourceAttr(resourceName, "maintenance.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance.0.timeout", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance.0.status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+const testMaintenanceBeanstalkGroupConfig_Create = `
+resource "` + string(commons.ElastigroupAWSBeanstalkResourceName) + `" "%v" {
+ provider = "%v"
+
+ name 	 = "%v"
+ product = "Linux/UNIX"
+ region  = "us-west-2"
+
+ max_size 		  = 2
+ min_size 		  = 0
+ desired_capacity = 1
+
+ beanstalk_environment_id = "e-g74pi5mwuy"
+ instance_types_spot        = ["t2.small"]
+
+ maintenance {
+  enabled = true
+ }
+}
+
+`
+
+const testMaintenanceBeanstalkGroupConfig_Update = `
+resource "` + string(commons.ElastigroupAWSBeanstalkResourceName) + `" "%v" {
+ provider = "%v"
+
+ name 	 = "%v"
+ product = "Linux/UNIX"
+ region  = "us-west-2"
+
+ max_size 		  = 2
+ min_size 		  = 0
+ desired_capacity = 1
+
+ beanstalk_environment_id = "e-g74pi5mwuy"
+ instance_types_spot        = ["t2.small"]
+
+ maintenance {
+  enabled = false
+  timeout = 3600
+ }
+}
+
+`
+
+// endregion
+
 // region Beanstalk Elastigroup: Scheduled Tasks
 func TestAccSpotinstElastigroupAWSBeanstalk_ScheduledTask(t *testing.T) {
 	groupName := "test-acc-bs-scheduled-task"
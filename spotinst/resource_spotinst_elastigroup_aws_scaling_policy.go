@@ -0,0 +1,289 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_scaling_policy"
+)
+
+// elastigroupAWSMutexKV serializes read-modify-write cycles of resources that
+// manage a part of an Elastigroup (e.g. a single scaling policy) by group ID.
+var elastigroupAWSMutexKV = mutexkv.NewMutexKV()
+
+func resourceSpotinstElastigroupAWSScalingPolicy() *schema.Resource {
+	setupElastigroupAWSScalingPolicyResource()
+
+	return &schema.Resource{
+		Create: resourceSpotinstElastigroupAWSScalingPolicyCreate,
+		Read:   resourceSpotinstElastigroupAWSScalingPolicyRead,
+		Update: resourceSpotinstElastigroupAWSScalingPolicyUpdate,
+		Delete: resourceSpotinstElastigroupAWSScalingPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: commons.ElastigroupAWSScalingPolicyResource.GetSchemaMap(),
+	}
+}
+
+func setupElastigroupAWSScalingPolicyResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	elastigroup_aws_scaling_policy.Setup(fieldsMap)
+
+	commons.ElastigroupAWSScalingPolicyResource = commons.NewElastigroupAWSScalingPolicyResource(fieldsMap)
+}
+
+func resourceSpotinstElastigroupAWSScalingPolicyCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnCreate), commons.ElastigroupAWSScalingPolicyResource.GetName())
+
+	spWrapper, err := commons.ElastigroupAWSScalingPolicyResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	groupID := spotinst.StringValue(spWrapper.GroupID)
+	policyType := spotinst.StringValue(spWrapper.PolicyType)
+	policy := spWrapper.GetScalingPolicy()
+	policyName := spotinst.StringValue(policy.PolicyName)
+
+	elastigroupAWSMutexKV.Lock(groupID)
+	defer elastigroupAWSMutexKV.Unlock(groupID)
+
+	group, err := readElastigroupAWS(groupID, meta.(*Client))
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return fmt.Errorf("[ERROR] Elastigroup %s does not exist", groupID)
+	}
+
+	policies := getGroupScalingPolicies(group.Scaling, policyType)
+	if _, i := findGroupScalingPolicy(policies, policyName); i >= 0 {
+		return fmt.Errorf("[ERROR] %s scaling policy %q already exists in group %s, import it instead",
+			policyType, policyName, groupID)
+	}
+
+	policies = append(policies, policy)
+	if err := updateGroupScalingPolicies(groupID, policyType, policies, meta.(*Client)); err != nil {
+		return err
+	}
+
+	resourceData.SetId(elastigroupAWSScalingPolicyID(groupID, policyType, policyName))
+	log.Printf("===> Elastigroup scaling policy created successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstElastigroupAWSScalingPolicyRead(resourceData, meta)
+}
+
+func resourceSpotinstElastigroupAWSScalingPolicyRead(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead), commons.ElastigroupAWSScalingPolicyResource.GetName(), id)
+
+	groupID, policyType, policyName, err := parseElastigroupAWSScalingPolicyID(id)
+	if err != nil {
+		return err
+	}
+
+	group, err := readElastigroupAWS(groupID, meta.(*Client))
+	if err != nil {
+		return err
+	}
+
+	// If the group or the policy was not found, return no state.
+	if group == nil {
+		resourceData.SetId("")
+		return nil
+	}
+	policy, _ := findGroupScalingPolicy(getGroupScalingPolicies(group.Scaling, policyType), policyName)
+	if policy == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	if err := commons.ElastigroupAWSScalingPolicyResource.OnRead(groupID, policyType, policy, resourceData, meta); err != nil {
+		return err
+	}
+
+	log.Printf("===> Elastigroup scaling policy read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstElastigroupAWSScalingPolicyUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate), commons.ElastigroupAWSScalingPolicyResource.GetName(), id)
+
+	groupID, policyType, policyName, err := parseElastigroupAWSScalingPolicyID(id)
+	if err != nil {
+		return err
+	}
+
+	elastigroupAWSMutexKV.Lock(groupID)
+	defer elastigroupAWSMutexKV.Unlock(groupID)
+
+	group, err := readElastigroupAWS(groupID, meta.(*Client))
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return fmt.Errorf("[ERROR] Elastigroup %s does not exist", groupID)
+	}
+
+	policies := getGroupScalingPolicies(group.Scaling, policyType)
+	current, i := findGroupScalingPolicy(policies, policyName)
+	if current == nil {
+		return fmt.Errorf("[ERROR] %s scaling policy %q no longer exists in group %s", policyType, policyName, groupID)
+	}
+
+	changeset, policy, err := commons.ElastigroupAWSScalingPolicyResource.OnUpdate(current, resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	if changeset.HasChanges() {
+		policies[i] = policy
+		if err := updateGroupScalingPolicies(groupID, policyType, policies, meta.(*Client)); err != nil {
+			return changeset.WrapError(err)
+		}
+	}
+
+	log.Printf("===> Elastigroup scaling policy updated successfully: %s <===", id)
+	return resourceSpotinstElastigroupAWSScalingPolicyRead(resourceData, meta)
+}
+
+func resourceSpotinstElastigroupAWSScalingPolicyDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete), commons.ElastigroupAWSScalingPolicyResource.GetName(), id)
+
+	groupID, policyType, policyName, err := parseElastigroupAWSScalingPolicyID(id)
+	if err != nil {
+		return err
+	}
+
+	elastigroupAWSMutexKV.Lock(groupID)
+	defer elastigroupAWSMutexKV.Unlock(groupID)
+
+	group, err := readElastigroupAWS(groupID, meta.(*Client))
+	if err != nil {
+		return err
+	}
+
+	if group != nil {
+		policies := getGroupScalingPolicies(group.Scaling, policyType)
+		if _, i := findGroupScalingPolicy(policies, policyName); i >= 0 {
+			policies = append(policies[:i], policies[i+1:]...)
+			if err := updateGroupScalingPolicies(groupID, policyType, policies, meta.(*Client)); err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Printf("===> Elastigroup scaling policy deleted successfully: %s <===", id)
+	resourceData.SetId("")
+	return nil
+}
+
+func elastigroupAWSScalingPolicyID(groupID, policyType, policyName string) string {
+	return fmt.Sprintf("%s/%s/%s", groupID, policyType, policyName)
+}
+
+func parseElastigroupAWSScalingPolicyID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("[ERROR] invalid scaling policy ID %q, expected <group_id>/<policy_type>/<policy_name>", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// readElastigroupAWS reads the group that owns a standalone part. A nil group is
+// returned when the group does not exist anymore.
+func readElastigroupAWS(groupID string, spotinstClient *Client) (*aws.Group, error) {
+	input := &aws.ReadGroupInput{GroupID: spotinst.String(groupID)}
+	resp, err := spotinstClient.elastigroup.CloudProviderAWS().Read(context.Background(), input)
+	if err != nil {
+		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+			for _, err := range errs {
+				if err.Code == ErrCodeGroupNotFound {
+					return nil, nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("failed to read group: %s", err)
+	}
+	return resp.Group, nil
+}
+
+func updateGroupScalingPolicies(groupID, policyType string, policies []*aws.ScalingPolicy, spotinstClient *Client) error {
+	scaling := &aws.Scaling{}
+	if err := setGroupScalingPolicies(scaling, policyType, policies); err != nil {
+		return err
+	}
+
+	group := &aws.Group{}
+	group.SetId(spotinst.String(groupID))
+	group.SetScaling(scaling)
+
+	if json, err := commons.ToJson(group); err != nil {
+		return err
+	} else {
+		log.Printf("===> Group scaling policies update configuration: %s", json)
+	}
+
+	input := &aws.UpdateGroupInput{Group: group}
+	if _, err := spotinstClient.elastigroup.CloudProviderAWS().Update(context.Background(), input); err != nil {
+		return fmt.Errorf("[ERROR] Failed to update scaling policies of group [%v]: %v", groupID, err)
+	}
+	return nil
+}
+
+func getGroupScalingPolicies(scaling *aws.Scaling, policyType string) []*aws.ScalingPolicy {
+	if scaling == nil {
+		return nil
+	}
+	switch policyType {
+	case elastigroup_aws_scaling_policy.PolicyTypeUp:
+		return scaling.Up
+	case elastigroup_aws_scaling_policy.PolicyTypeDown:
+		return scaling.Down
+	case elastigroup_aws_scaling_policy.PolicyTypeTarget:
+		return scaling.Target
+	}
+	return nil
+}
+
+func setGroupScalingPolicies(scaling *aws.Scaling, policyType string, policies []*aws.ScalingPolicy) error {
+	// An empty list is omitted from the request, null clears it.
+	if len(policies) == 0 {
+		policies = nil
+	}
+
+	switch policyType {
+	case elastigroup_aws_scaling_policy.PolicyTypeUp:
+		scaling.SetUp(policies)
+	case elastigroup_aws_scaling_policy.PolicyTypeDown:
+		scaling.SetDown(policies)
+	case elastigroup_aws_scaling_policy.PolicyTypeTarget:
+		scaling.SetTarget(policies)
+	default:
+		return fmt.Errorf("[ERROR] unsupported scaling policy type %q", policyType)
+	}
+	return nil
+}
+
+func findGroupScalingPolicy(policies []*aws.ScalingPolicy, policyName string) (*aws.ScalingPolicy, int) {
+	for i, policy := range policies {
+		if policy != nil && spotinst.StringValue(policy.PolicyName) == policyName {
+			return policy, i
+		}
+	}
+	return nil, -1
+}
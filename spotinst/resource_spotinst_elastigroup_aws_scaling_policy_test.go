@@ -0,0 +1,210 @@
+package spotinst
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createElastigroupAWSScalingPolicyResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.ElastigroupAWSScalingPolicyResourceName), name)
+}
+
+func testElastigroupAWSScalingPolicyDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.ElastigroupAWSScalingPolicyResourceName) {
+			continue
+		}
+		groupID, policyType, policyName, err := parseElastigroupAWSScalingPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		group, err := readElastigroupAWS(groupID, client)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			continue
+		}
+		if policy, _ := findGroupScalingPolicy(getGroupScalingPolicies(group.Scaling, policyType), policyName); policy != nil {
+			return fmt.Errorf("scaling policy still exists")
+		}
+	}
+	return nil
+}
+
+func testCheckElastigroupAWSScalingPolicyExists(policy *aws.ScalingPolicy, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		groupID, policyType, policyName, err := parseElastigroupAWSScalingPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := testAccProviderAWS.Meta().(*Client)
+		group, err := readElastigroupAWS(groupID, client)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return fmt.Errorf("group not found: %s", groupID)
+		}
+		found, _ := findGroupScalingPolicy(getGroupScalingPolicies(group.Scaling, policyType), policyName)
+		if found == nil {
+			return fmt.Errorf("scaling policy not found: %+v", rs.Primary.Attributes)
+		}
+		*policy = *found
+		return nil
+	}
+}
+
+func testCheckElastigroupAWSScalingPolicyAttributes(policy *aws.ScalingPolicy, expectedName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if spotinst.StringValue(policy.PolicyName) != expectedName {
+			return fmt.Errorf("bad content: %v", policy.PolicyName)
+		}
+		return nil
+	}
+}
+
+type ElastigroupAWSScalingPolicyMetadata struct {
+	provider             string
+	name                 string
+	groupID              string
+	updateBaselineFields bool
+}
+
+func createElastigroupAWSScalingPolicyTerraform(spm *ElastigroupAWSScalingPolicyMetadata) string {
+	if spm == nil {
+		return ""
+	}
+
+	if spm.provider == "" {
+		spm.provider = "aws"
+	}
+
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	format := testBaselineElastigroupAWSScalingPolicyConfig_Create
+	if spm.updateBaselineFields {
+		format = testBaselineElastigroupAWSScalingPolicyConfig_Update
+	}
+
+	template += fmt.Sprintf(format,
+		spm.name,
+		spm.provider,
+		spm.groupID,
+		spm.name,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", spm.name, template)
+	return template
+}
+
+// region ElastigroupAWSScalingPolicy: Baseline
+func TestAccSpotinstElastigroupAWSScalingPolicy_Baseline(t *testing.T) {
+	groupID := "sig-05d0a009"
+	policyName := "test-acc-scaling-policy"
+	resourceName := createElastigroupAWSScalingPolicyResourceName(policyName)
+
+	var policy aws.ScalingPolicy
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testElastigroupAWSScalingPolicyDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createElastigroupAWSScalingPolicyTerraform(&ElastigroupAWSScalingPolicyMetadata{
+					name:    policyName,
+					groupID: groupID,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAWSScalingPolicyExists(&policy, resourceName),
+					testCheckElastigroupAWSScalingPolicyAttributes(&policy, policyName),
+					resource.TestCheckResourceAttr(resourceName, "group_id", groupID),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "up"),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", "CPUUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "AWS/EC2"),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "80"),
+					resource.TestCheckResourceAttr(resourceName, "action_type", "adjustment"),
+					resource.TestCheckResourceAttr(resourceName, "adjustment", "1"),
+				),
+			},
+			{
+				Config: createElastigroupAWSScalingPolicyTerraform(&ElastigroupAWSScalingPolicyMetadata{
+					name:                 policyName,
+					groupID:              groupID,
+					updateBaselineFields: true,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAWSScalingPolicyExists(&policy, resourceName),
+					testCheckElastigroupAWSScalingPolicyAttributes(&policy, policyName),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "90"),
+					resource.TestCheckResourceAttr(resourceName, "action_type", "adjustment"),
+					resource.TestCheckResourceAttr(resourceName, "adjustment", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cooldown", "120"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testBaselineElastigroupAWSScalingPolicyConfig_Create = `
+resource "` + string(commons.ElastigroupAWSScalingPolicyResourceName) + `" "%v" {
+ provider = "%v"
+
+ group_id    = "%v"
+ policy_type = "up"
+ policy_name = "%v"
+ metric_name = "CPUUtilization"
+ namespace   = "AWS/EC2"
+ statistic   = "average"
+ unit        = "percent"
+ threshold   = 80
+ action_type = "adjustment"
+ adjustment  = "1"
+}
+`
+
+const testBaselineElastigroupAWSScalingPolicyConfig_Update = `
+resource "` + string(commons.ElastigroupAWSScalingPolicyResourceName) + `" "%v" {
+ provider = "%v"
+
+ group_id    = "%v"
+ policy_type = "up"
+ policy_name = "%v"
+ metric_name = "CPUUtilization"
+ namespace   = "AWS/EC2"
+ statistic   = "average"
+ unit        = "percent"
+ threshold   = 90
+ cooldown    = 120
+ action_type = "adjustment"
+ adjustment  = "2"
+}
+`
+
+// endregion
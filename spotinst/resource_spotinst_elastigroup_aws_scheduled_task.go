@@ -0,0 +1,293 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_scheduled_task"
+)
+
+func resourceSpotinstElastigroupAWSScheduledTask() *schema.Resource {
+	setupElastigroupAWSScheduledTaskResource()
+
+	return &schema.Resource{
+		Create: resourceSpotinstElastigroupAWSScheduledTaskCreate,
+		Read:   resourceSpotinstElastigroupAWSScheduledTaskRead,
+		Update: resourceSpotinstElastigroupAWSScheduledTaskUpdate,
+		Delete: resourceSpotinstElastigroupAWSScheduledTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceSpotinstElastigroupAWSScheduledTaskImportState,
+		},
+
+		Schema: commons.ElastigroupAWSScheduledTaskResource.GetSchemaMap(),
+	}
+}
+
+func setupElastigroupAWSScheduledTaskResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	elastigroup_aws_scheduled_task.SetupResource(fieldsMap)
+
+	commons.ElastigroupAWSScheduledTaskResource = commons.NewElastigroupAWSScheduledTaskResource(fieldsMap)
+}
+
+func resourceSpotinstElastigroupAWSScheduledTaskCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnCreate), commons.ElastigroupAWSScheduledTaskResource.GetName())
+
+	stWrapper, err := commons.ElastigroupAWSScheduledTaskResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	groupID := spotinst.StringValue(stWrapper.GroupID)
+	task := stWrapper.GetScheduledTask()
+	if task.Frequency == nil && task.CronExpression == nil {
+		return fmt.Errorf("[ERROR] one of %q or %q must be set",
+			string(elastigroup_aws_scheduled_task.Frequency), string(elastigroup_aws_scheduled_task.CronExpression))
+	}
+	taskKey := elastigroup_aws_scheduled_task.TaskKey(task)
+
+	elastigroupAWSMutexKV.Lock(groupID)
+	defer elastigroupAWSMutexKV.Unlock(groupID)
+
+	group, err := readElastigroupAWS(groupID, meta.(*Client))
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return fmt.Errorf("[ERROR] Elastigroup %s does not exist", groupID)
+	}
+
+	tasks := getGroupScheduledTasks(group)
+	if _, i := findGroupScheduledTask(tasks, taskKey); i >= 0 {
+		return fmt.Errorf("[ERROR] an identical scheduled task already exists in group %s, import it instead", groupID)
+	}
+
+	tasks = append(tasks, task)
+	if err := updateGroupScheduledTasks(groupID, tasks, meta.(*Client)); err != nil {
+		return err
+	}
+
+	resourceData.SetId(elastigroupAWSScheduledTaskID(groupID, taskKey))
+	log.Printf("===> Elastigroup scheduled task created successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstElastigroupAWSScheduledTaskRead(resourceData, meta)
+}
+
+func resourceSpotinstElastigroupAWSScheduledTaskRead(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead), commons.ElastigroupAWSScheduledTaskResource.GetName(), id)
+
+	groupID, taskKey, err := parseElastigroupAWSScheduledTaskID(id)
+	if err != nil {
+		return err
+	}
+
+	group, err := readElastigroupAWS(groupID, meta.(*Client))
+	if err != nil {
+		return err
+	}
+
+	// If the group or the task was not found, return no state.
+	if group == nil {
+		resourceData.SetId("")
+		return nil
+	}
+	task, _ := findGroupScheduledTask(getGroupScheduledTasks(group), taskKey)
+	if task == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	if err := commons.ElastigroupAWSScheduledTaskResource.OnRead(groupID, task, resourceData, meta); err != nil {
+		return err
+	}
+
+	log.Printf("===> Elastigroup scheduled task read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstElastigroupAWSScheduledTaskUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate), commons.ElastigroupAWSScheduledTaskResource.GetName(), id)
+
+	groupID, taskKey, err := parseElastigroupAWSScheduledTaskID(id)
+	if err != nil {
+		return err
+	}
+
+	elastigroupAWSMutexKV.Lock(groupID)
+	defer elastigroupAWSMutexKV.Unlock(groupID)
+
+	group, err := readElastigroupAWS(groupID, meta.(*Client))
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return fmt.Errorf("[ERROR] Elastigroup %s does not exist", groupID)
+	}
+
+	tasks := getGroupScheduledTasks(group)
+	current, i := findGroupScheduledTask(tasks, taskKey)
+	if current == nil {
+		return fmt.Errorf("[ERROR] scheduled task %s no longer exists in group %s", taskKey, groupID)
+	}
+
+	changeset, task, err := commons.ElastigroupAWSScheduledTaskResource.OnUpdate(current, resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	if changeset.HasChanges() {
+		if task.Frequency == nil && task.CronExpression == nil {
+			return fmt.Errorf("[ERROR] one of %q or %q must be set",
+				string(elastigroup_aws_scheduled_task.Frequency), string(elastigroup_aws_scheduled_task.CronExpression))
+		}
+
+		// Changing the schedule of the task changes its key as well.
+		newKey := elastigroup_aws_scheduled_task.TaskKey(task)
+		if newKey != taskKey {
+			if _, j := findGroupScheduledTask(tasks, newKey); j >= 0 {
+				return fmt.Errorf("[ERROR] an identical scheduled task already exists in group %s", groupID)
+			}
+		}
+
+		tasks[i] = task
+		if err := updateGroupScheduledTasks(groupID, tasks, meta.(*Client)); err != nil {
+			return changeset.WrapError(err)
+		}
+		resourceData.SetId(elastigroupAWSScheduledTaskID(groupID, newKey))
+	}
+
+	log.Printf("===> Elastigroup scheduled task updated successfully: %s <===", resourceData.Id())
+	return resourceSpotinstElastigroupAWSScheduledTaskRead(resourceData, meta)
+}
+
+func resourceSpotinstElastigroupAWSScheduledTaskDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete), commons.ElastigroupAWSScheduledTaskResource.GetName(), id)
+
+	groupID, taskKey, err := parseElastigroupAWSScheduledTaskID(id)
+	if err != nil {
+		return err
+	}
+
+	elastigroupAWSMutexKV.Lock(groupID)
+	defer elastigroupAWSMutexKV.Unlock(groupID)
+
+	group, err := readElastigroupAWS(groupID, meta.(*Client))
+	if err != nil {
+		return err
+	}
+
+	if group != nil {
+		tasks := getGroupScheduledTasks(group)
+		if _, i := findGroupScheduledTask(tasks, taskKey); i >= 0 {
+			tasks = append(tasks[:i], tasks[i+1:]...)
+			if err := updateGroupScheduledTasks(groupID, tasks, meta.(*Client)); err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Printf("===> Elastigroup scheduled task deleted successfully: %s <===", id)
+	resourceData.SetId("")
+	return nil
+}
+
+// region Import
+
+// resourceSpotinstElastigroupAWSScheduledTaskImportState accepts either the
+// resource ID (`<group_id>/<task_key>`) or `<group_id>/<index>`, where index is
+// the zero-based position of the task in the group's task list.
+func resourceSpotinstElastigroupAWSScheduledTaskImportState(
+	resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+
+	groupID, ref, err := parseElastigroupAWSScheduledTaskID(resourceData.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := readElastigroupAWS(groupID, meta.(*Client))
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, fmt.Errorf("[ERROR] Elastigroup %s does not exist", groupID)
+	}
+
+	tasks := getGroupScheduledTasks(group)
+	if task, _ := findGroupScheduledTask(tasks, ref); task == nil {
+		index, err := strconv.Atoi(ref)
+		if err != nil || index < 0 || index >= len(tasks) {
+			return nil, fmt.Errorf("[ERROR] scheduled task %q not found in group %s", ref, groupID)
+		}
+		resourceData.SetId(elastigroupAWSScheduledTaskID(groupID, elastigroup_aws_scheduled_task.TaskKey(tasks[index])))
+	}
+
+	return []*schema.ResourceData{resourceData}, nil
+}
+
+// endregion
+
+func elastigroupAWSScheduledTaskID(groupID, taskKey string) string {
+	return fmt.Sprintf("%s/%s", groupID, taskKey)
+}
+
+func parseElastigroupAWSScheduledTaskID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] invalid scheduled task ID %q, expected <group_id>/<task_key>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func updateGroupScheduledTasks(groupID string, tasks []*aws.Task, spotinstClient *Client) error {
+	// An empty list is omitted from the request, null clears it.
+	if len(tasks) == 0 {
+		tasks = nil
+	}
+
+	scheduling := &aws.Scheduling{}
+	scheduling.SetTasks(tasks)
+
+	group := &aws.Group{}
+	group.SetId(spotinst.String(groupID))
+	group.SetScheduling(scheduling)
+
+	if json, err := commons.ToJson(group); err != nil {
+		return err
+	} else {
+		log.Printf("===> Group scheduled tasks update configuration: %s", json)
+	}
+
+	input := &aws.UpdateGroupInput{Group: group}
+	if _, err := spotinstClient.elastigroup.CloudProviderAWS().Update(context.Background(), input); err != nil {
+		return fmt.Errorf("[ERROR] Failed to update scheduled tasks of group [%v]: %v", groupID, err)
+	}
+	return nil
+}
+
+func getGroupScheduledTasks(group *aws.Group) []*aws.Task {
+	if group == nil || group.Scheduling == nil {
+		return nil
+	}
+	return group.Scheduling.Tasks
+}
+
+func findGroupScheduledTask(tasks []*aws.Task, taskKey string) (*aws.Task, int) {
+	for i, task := range tasks {
+		if task != nil && elastigroup_aws_scheduled_task.TaskKey(task) == taskKey {
+			return task, i
+		}
+	}
+	return nil, -1
+}
@@ -0,0 +1,243 @@
+package spotinst
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createElastigroupAWSScheduledTaskResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.ElastigroupAWSScheduledTaskResourceName), name)
+}
+
+func testElastigroupAWSScheduledTaskDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.ElastigroupAWSScheduledTaskResourceName) {
+			continue
+		}
+		groupID, taskKey, err := parseElastigroupAWSScheduledTaskID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		group, err := readElastigroupAWS(groupID, client)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			continue
+		}
+		if task, _ := findGroupScheduledTask(getGroupScheduledTasks(group), taskKey); task != nil {
+			return fmt.Errorf("scheduled task still exists")
+		}
+	}
+	return nil
+}
+
+func testCheckElastigroupAWSScheduledTaskExists(task *aws.Task, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		groupID, taskKey, err := parseElastigroupAWSScheduledTaskID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := testAccProviderAWS.Meta().(*Client)
+		group, err := readElastigroupAWS(groupID, client)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return fmt.Errorf("group not found: %s", groupID)
+		}
+		found, _ := findGroupScheduledTask(getGroupScheduledTasks(group), taskKey)
+		if found == nil {
+			return fmt.Errorf("scheduled task not found: %+v", rs.Primary.Attributes)
+		}
+		*task = *found
+		return nil
+	}
+}
+
+func testCheckElastigroupAWSScheduledTaskAttributes(task *aws.Task, expectedCron string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if spotinst.StringValue(task.CronExpression) != expectedCron {
+			return fmt.Errorf("bad content: %v", task.CronExpression)
+		}
+		return nil
+	}
+}
+
+type ElastigroupAWSScheduledTaskMetadata struct {
+	provider             string
+	name                 string
+	groupID              string
+	updateBaselineFields bool
+}
+
+func createElastigroupAWSScheduledTaskTerraform(stm *ElastigroupAWSScheduledTaskMetadata) string {
+	if stm == nil {
+		return ""
+	}
+
+	if stm.provider == "" {
+		stm.provider = "aws"
+	}
+
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	format := testBaselineElastigroupAWSScheduledTaskConfig_Create
+	if stm.updateBaselineFields {
+		format = testBaselineElastigroupAWSScheduledTaskConfig_Update
+	}
+
+	template += fmt.Sprintf(format,
+		stm.name,
+		stm.provider,
+		stm.groupID,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", stm.name, template)
+	return template
+}
+
+// region ElastigroupAWSScheduledTask: Baseline
+func TestAccSpotinstElastigroupAWSScheduledTask_Baseline(t *testing.T) {
+	groupID := "sig-05d0a009"
+	taskName := "test-acc-scheduled-task"
+	resourceName := createElastigroupAWSScheduledTaskResourceName(taskName)
+
+	var task aws.Task
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testElastigroupAWSScheduledTaskDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createElastigroupAWSScheduledTaskTerraform(&ElastigroupAWSScheduledTaskMetadata{
+					name:    taskName,
+					groupID: groupID,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAWSScheduledTaskExists(&task, resourceName),
+					testCheckElastigroupAWSScheduledTaskAttributes(&task, "0 22 * * MON-FRI"),
+					resource.TestCheckResourceAttr(resourceName, "group_id", groupID),
+					resource.TestCheckResourceAttr(resourceName, "task_type", "scale"),
+					resource.TestCheckResourceAttr(resourceName, "cron_expression", "0 22 * * MON-FRI"),
+					resource.TestCheckResourceAttr(resourceName, "scale_target_capacity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scale_min_capacity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "next_executions.#", "5"),
+				),
+			},
+			{
+				Config: createElastigroupAWSScheduledTaskTerraform(&ElastigroupAWSScheduledTaskMetadata{
+					name:                 taskName,
+					groupID:              groupID,
+					updateBaselineFields: true,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAWSScheduledTaskExists(&task, resourceName),
+					testCheckElastigroupAWSScheduledTaskAttributes(&task, "0 23 * * MON-FRI"),
+					resource.TestCheckResourceAttr(resourceName, "cron_expression", "0 23 * * MON-FRI"),
+					resource.TestCheckResourceAttr(resourceName, "scale_target_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "is_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testBaselineElastigroupAWSScheduledTaskConfig_Create = `
+resource "` + string(commons.ElastigroupAWSScheduledTaskResourceName) + `" "%v" {
+ provider = "%v"
+
+ group_id              = "%v"
+ task_type             = "scale"
+ cron_expression       = "0 22 * * MON-FRI"
+ scale_target_capacity = 0
+ scale_min_capacity    = 0
+}
+`
+
+const testBaselineElastigroupAWSScheduledTaskConfig_Update = `
+resource "` + string(commons.ElastigroupAWSScheduledTaskResourceName) + `" "%v" {
+ provider = "%v"
+
+ group_id              = "%v"
+ task_type             = "scale"
+ cron_expression       = "0 23 * * MON-FRI"
+ scale_target_capacity = 1
+ scale_min_capacity    = 0
+ is_enabled            = false
+}
+`
+
+// endregion
+
+// region ElastigroupAWSScheduledTask: StartTime
+func TestAccSpotinstElastigroupAWSScheduledTask_StartTime(t *testing.T) {
+	groupID := "sig-05d0a009"
+	taskName := "test-acc-scheduled-task-start-time"
+	resourceName := createElastigroupAWSScheduledTaskResourceName(taskName)
+
+	var task aws.Task
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testElastigroupAWSScheduledTaskDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testStartTimeElastigroupAWSScheduledTaskConfig, taskName, groupID),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAWSScheduledTaskExists(&task, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "daily"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", "2030-01-01T22:00:00"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "Europe/Berlin"),
+				),
+			},
+		},
+	})
+}
+
+const testStartTimeElastigroupAWSScheduledTaskConfig = `
+provider "aws" {
+ token   = "fake"
+ account = "fake"
+}
+
+resource "` + string(commons.ElastigroupAWSScheduledTaskResourceName) + `" "%v" {
+ provider = "aws"
+
+ group_id    = "%v"
+ task_type   = "backup_ami"
+ frequency   = "daily"
+ start_time  = "2030-01-01T22:00:00"
+ timezone    = "Europe/Berlin"
+}
+`
+
+// endregion
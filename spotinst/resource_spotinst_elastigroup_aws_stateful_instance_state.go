@@ -0,0 +1,189 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_stateful_instance_state"
+)
+
+func resourceSpotinstElastigroupAWSStatefulInstanceState() *schema.Resource {
+	setupElastigroupAWSStatefulInstanceStateResource()
+
+	return &schema.Resource{
+		Create: resourceSpotinstElastigroupAWSStatefulInstanceStateCreate,
+		Read:   resourceSpotinstElastigroupAWSStatefulInstanceStateRead,
+		Update: resourceSpotinstElastigroupAWSStatefulInstanceStateUpdate,
+		Delete: deleteInstanceState(commons.ElastigroupAWSStatefulInstanceStateResource.GetName()),
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: commons.ElastigroupAWSStatefulInstanceStateResource.GetSchemaMap(),
+	}
+}
+
+func setupElastigroupAWSStatefulInstanceStateResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	elastigroup_aws_stateful_instance_state.Setup(fieldsMap)
+
+	commons.ElastigroupAWSStatefulInstanceStateResource = commons.NewElastigroupAWSStatefulInstanceStateResource(fieldsMap)
+}
+
+func resourceSpotinstElastigroupAWSStatefulInstanceStateCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.ElastigroupAWSStatefulInstanceStateResource.GetName())
+
+	state, err := commons.ElastigroupAWSStatefulInstanceStateResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	// The ID is only set once the state is reached, so that a failed
+	// transition is run again by the next apply.
+	if err := convergeElastigroupAWSStatefulInstanceState(state, meta.(*Client)); err != nil {
+		return err
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s/%s",
+		spotinst.StringValue(state.ElastigroupID),
+		spotinst.StringValue(state.StatefulInstanceID)))
+	log.Printf("===> Stateful instance state created successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstElastigroupAWSStatefulInstanceStateRead(resourceData, meta)
+}
+
+func resourceSpotinstElastigroupAWSStatefulInstanceStateRead(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.ElastigroupAWSStatefulInstanceStateResource.GetName(), id)
+
+	groupID, statefulInstanceID, err := parseElastigroupAWSStatefulInstanceStateID(id)
+	if err != nil {
+		return err
+	}
+
+	group, err := readElastigroupAWS(groupID, meta.(*Client))
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	statefulInstance, err := readElastigroupAWSStatefulInstance(groupID, statefulInstanceID, meta.(*Client))
+	if err != nil {
+		return err
+	}
+
+	// If the stateful instance is gone, e.g. deallocated, so is its state.
+	if statefulInstance == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	state := &commons.ElastigroupAWSStatefulInstanceStateSpec{
+		ElastigroupID:      spotinst.String(groupID),
+		StatefulInstanceID: spotinst.String(statefulInstanceID),
+		State:              instanceStateFromStatus(statefulInstance.State),
+		Status:             statefulInstance.State,
+		InstanceID:         statefulInstance.InstanceID,
+		PrivateIP:          statefulInstance.PrivateIP,
+	}
+
+	if err := commons.ElastigroupAWSStatefulInstanceStateResource.OnRead(state, resourceData, meta); err != nil {
+		return err
+	}
+
+	log.Printf("===> Stateful instance state read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstElastigroupAWSStatefulInstanceStateUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.ElastigroupAWSStatefulInstanceStateResource.GetName(), resourceData.Id())
+
+	changeset, state, err := commons.ElastigroupAWSStatefulInstanceStateResource.OnUpdate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	if err := updateInstanceState(resourceData, changeset, func() error {
+		return convergeElastigroupAWSStatefulInstanceState(state, meta.(*Client))
+	}); err != nil {
+		return err
+	}
+
+	log.Printf("===> Stateful instance state updated successfully: %s <===", resourceData.Id())
+	return resourceSpotinstElastigroupAWSStatefulInstanceStateRead(resourceData, meta)
+}
+
+func parseElastigroupAWSStatefulInstanceStateID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] invalid stateful instance state ID %q, expected "+
+			"<elastigroup_id>/<stateful_instance_id>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// convergeElastigroupAWSStatefulInstanceState pauses or resumes the stateful
+// instance to reach the desired state.
+func convergeElastigroupAWSStatefulInstanceState(state *commons.ElastigroupAWSStatefulInstanceStateSpec, client *Client) error {
+	if json, err := commons.ToJson(state); err != nil {
+		return err
+	} else {
+		log.Printf("===> Stateful instance state configuration: %s", json)
+	}
+
+	groupID := spotinst.StringValue(state.ElastigroupID)
+	id := spotinst.StringValue(state.StatefulInstanceID)
+	svc := client.elastigroup.CloudProviderAWS()
+
+	return convergeInstanceState(spotinst.StringValue(state.State), spotinst.IntValue(state.Timeout), instanceStateFuncs{
+		name: fmt.Sprintf("stateful instance %s", id),
+		read: func() (*string, error) {
+			statefulInstance, err := readElastigroupAWSStatefulInstance(groupID, id, client)
+			if err != nil {
+				return nil, err
+			}
+			if statefulInstance == nil {
+				return nil, fmt.Errorf("stateful instance %s not found in Elastigroup %s", id, groupID)
+			}
+			return statefulInstance.State, nil
+		},
+		pause:  func() error { return pauseStatefulInstance(context.Background(), svc, groupID, id) },
+		resume: func() error { return resumeStatefulInstance(context.Background(), svc, groupID, id) },
+	})
+}
+
+func listElastigroupAWSStatefulInstances(groupID string, spotinstClient *Client) ([]*aws.StatefulInstance, error) {
+	input := &aws.ListStatefulInstancesInput{GroupID: spotinst.String(groupID)}
+	resp, err := spotinstClient.elastigroup.CloudProviderAWS().ListStatefulInstances(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stateful instances of group %s: %s", groupID, err)
+	}
+	return resp.StatefulInstances, nil
+}
+
+func readElastigroupAWSStatefulInstance(groupID, statefulInstanceID string, spotinstClient *Client) (*aws.StatefulInstance, error) {
+	statefulInstances, err := listElastigroupAWSStatefulInstances(groupID, spotinstClient)
+	if err != nil {
+		return nil, err
+	}
+	for _, statefulInstance := range statefulInstances {
+		if spotinst.StringValue(statefulInstance.StatefulInstanceID) == statefulInstanceID {
+			return statefulInstance, nil
+		}
+	}
+	return nil, nil
+}
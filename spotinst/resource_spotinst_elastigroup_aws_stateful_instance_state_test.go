@@ -0,0 +1,116 @@
+package spotinst
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createElastigroupAWSStatefulInstanceStateResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.ElastigroupAWSStatefulInstanceStateResourceName), name)
+}
+
+type ElastigroupAWSStatefulInstanceStateMetadata struct {
+	provider string
+	name     string
+	groupID  string
+	state    string
+}
+
+func createElastigroupAWSStatefulInstanceStateTerraform(ssm *ElastigroupAWSStatefulInstanceStateMetadata) string {
+	if ssm == nil {
+		return ""
+	}
+
+	if ssm.provider == "" {
+		ssm.provider = "aws"
+	}
+
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	template += fmt.Sprintf(testBaselineElastigroupAWSStatefulInstanceStateConfig,
+		ssm.provider,
+		ssm.groupID,
+		ssm.name,
+		ssm.provider,
+		ssm.groupID,
+		ssm.state,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", ssm.name, template)
+	return template
+}
+
+// region ElastigroupAWSStatefulInstanceState: Baseline
+func TestAccSpotinstElastigroupAWSStatefulInstanceState_Baseline(t *testing.T) {
+	groupID := "sig-05d0a009"
+	name := "test-acc-stateful-instance-state"
+	resourceName := createElastigroupAWSStatefulInstanceStateResourceName(name)
+	dataSourceName := "data." + string(commons.ElastigroupAWSStatefulInstancesDataSourceName) + ".foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t, "aws") },
+		Providers: TestAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createElastigroupAWSStatefulInstanceStateTerraform(&ElastigroupAWSStatefulInstanceStateMetadata{
+					name:    name,
+					groupID: groupID,
+					state:   "paused",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "stateful_instance_id", dataSourceName, "ids.0"),
+					resource.TestCheckResourceAttr(resourceName, "elastigroup_id", groupID),
+					resource.TestCheckResourceAttr(resourceName, "state", "paused"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "stateful_instances.0.state"),
+				),
+			},
+			{
+				Config: createElastigroupAWSStatefulInstanceStateTerraform(&ElastigroupAWSStatefulInstanceStateMetadata{
+					name:    name,
+					groupID: groupID,
+					state:   "running",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "running"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_ip"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeout"},
+			},
+		},
+	})
+}
+
+const testBaselineElastigroupAWSStatefulInstanceStateConfig = `
+data "` + string(commons.ElastigroupAWSStatefulInstancesDataSourceName) + `" "foo" {
+ provider       = "%v"
+ elastigroup_id = "%v"
+}
+
+resource "` + string(commons.ElastigroupAWSStatefulInstanceStateResourceName) + `" "%v" {
+ provider = "%v"
+
+ elastigroup_id       = "%v"
+ stateful_instance_id = "${data.` + string(commons.ElastigroupAWSStatefulInstancesDataSourceName) + `.foo.ids[0]}"
+ state                = "%v"
+ timeout              = 900
+}
+`
+
+// endregion
@@ -176,13 +176,13 @@ func resourceSpotinstAWSSuspendProcessesUpdate(resourceData *schema.ResourceData
 	resourceId := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.SuspendProcessesResource.GetName(), resourceId)
 
-	shouldUpdate, suspendProcesses, err := commons.SuspendProcessesResource.OnUpdate(resourceData, meta)
+	changeset, suspendProcesses, err := commons.SuspendProcessesResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		if err := updateSuspendProcesses(suspendProcesses, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 	log.Printf("===> SuspendProcesses updated successfully: %s <===", resourceId)
@@ -152,15 +152,15 @@ func resourceSpotinstElastigroupAzureUpdate(resourceData *schema.ResourceData, m
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.ElastigroupAzureResource.GetName(), id)
 
-	shouldUpdate, elastigroup, err := commons.ElastigroupAzureResource.OnUpdate(resourceData, meta)
+	changeset, elastigroup, err := commons.ElastigroupAzureResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		elastigroup.SetId(spotinst.String(id))
 		if err := updateAzureGroup(elastigroup, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
@@ -440,9 +440,9 @@ const testAzureImageGroupConfig_Create = `
 //				Check: resource.ComposeTestCheckFunc(
 //					testCheckElastigroupAzureExists(&group, resourceName),
 //					testCheckElastigroupAzureAttributes(&group, groupName),
-//					resource.TestCheckResourceAttr(resourceName, "user_data", elastigroup_azure_launch_configuration.Base64StateFunc("hello world")),
-//					resource.TestCheckResourceAttr(resourceName, "shutdown_script", elastigroup_azure_launch_configuration.Base64StateFunc("goodbye world")),
-//					resource.TestCheckResourceAttr(resourceName, "custom_data", elastigroup_azure_launch_configuration.Base64StateFunc("custom world")),
+//					resource.TestCheckResourceAttr(resourceName, "user_data", commons.UserDataStateFunc("hello world")),
+//					resource.TestCheckResourceAttr(resourceName, "shutdown_script", commons.Base64StateFunc("goodbye world")),
+//					resource.TestCheckResourceAttr(resourceName, "custom_data", commons.Base64StateFunc("custom world")),
 //				),
 //			},
 //			{
@@ -453,9 +453,9 @@ const testAzureImageGroupConfig_Create = `
 //				Check: resource.ComposeTestCheckFunc(
 //					testCheckElastigroupAzureExists(&group, resourceName),
 //					testCheckElastigroupAzureAttributes(&group, groupName),
-//					resource.TestCheckResourceAttr(resourceName, "user_data", elastigroup_azure_launch_configuration.Base64StateFunc("hello world")),
-//					resource.TestCheckResourceAttr(resourceName, "shutdown_script", elastigroup_azure_launch_configuration.Base64StateFunc("goodbye world updated")),
-//					resource.TestCheckResourceAttr(resourceName, "custom_data", elastigroup_azure_launch_configuration.Base64StateFunc("custom world")),
+//					resource.TestCheckResourceAttr(resourceName, "user_data", commons.UserDataStateFunc("hello world")),
+//					resource.TestCheckResourceAttr(resourceName, "shutdown_script", commons.Base64StateFunc("goodbye world updated")),
+//					resource.TestCheckResourceAttr(resourceName, "custom_data", commons.Base64StateFunc("custom world")),
 //				),
 //			},
 //			{
@@ -466,7 +466,7 @@ const testAzureImageGroupConfig_Create = `
 //				Check: resource.ComposeTestCheckFunc(
 //					testCheckElastigroupAzureExists(&group, resourceName),
 //					testCheckElastigroupAzureAttributes(&group, groupName),
-//					resource.TestCheckResourceAttr(resourceName, "user_data", elastigroup_azure_launch_configuration.Base64StateFunc("hello world")),
+//					resource.TestCheckResourceAttr(resourceName, "user_data", commons.UserDataStateFunc("hello world")),
 //				),
 //			},
 //		},
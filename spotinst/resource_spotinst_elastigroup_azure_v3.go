@@ -139,15 +139,15 @@ func resourceSpotinstElastigroupAzureV3Update(resourceData *schema.ResourceData,
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.ElastigroupAzureV3Resource.GetName(), id)
 
-	shouldUpdate, elastigroup, err := commons.ElastigroupAzureV3Resource.OnUpdate(resourceData, meta)
+	changeset, elastigroup, err := commons.ElastigroupAzureV3Resource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		elastigroup.SetId(spotinst.String(id))
 		if err := updateAzureV3Group(elastigroup, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
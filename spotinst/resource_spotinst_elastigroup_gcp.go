@@ -156,15 +156,15 @@ func resourceSpotinstElastigroupGCPUpdate(resourceData *schema.ResourceData, met
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.ElastigroupGCPResource.GetName(), groupId)
 
-	shouldUpdate, elastigroup, err := commons.ElastigroupGCPResource.OnUpdate(resourceData, meta)
+	changeset, elastigroup, err := commons.ElastigroupGCPResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		elastigroup.SetID(spotinst.String(groupId))
 		if err := updateGCPGroup(elastigroup, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
@@ -366,7 +366,7 @@ const testInstanceTypesGCPGroupConfig_Update = `
 //					testCheckElastigroupGCPAttributes(&group, groupName),
 //					resource.TestCheckResourceAttr(resourceName, "service_account", "265168459660-compute@developer.gserviceaccount.com"),
 //					resource.TestCheckResourceAttr(resourceName, "startup_script", "echo hello world"),
-//					resource.TestCheckResourceAttr(resourceName, "shutdown_script", elastigroup_gcp_launch_configuration.Base64StateFunc("echo goodbye world")),
+//					resource.TestCheckResourceAttr(resourceName, "shutdown_script", commons.Base64StateFunc("echo goodbye world")),
 //					resource.TestCheckResourceAttr(resourceName, "backend_services.#", "1"),
 //					resource.TestCheckResourceAttr(resourceName, "backend_services."+BackendSvcHash_create+".named_ports.#", "1"),
 //					resource.TestCheckResourceAttr(resourceName, "backend_services."+BackendSvcHash_create+".service_name", "terraform-bs-dont-delete"),
@@ -397,7 +397,7 @@ const testInstanceTypesGCPGroupConfig_Update = `
 //					testCheckElastigroupGCPAttributes(&group, groupName),
 //					resource.TestCheckResourceAttr(resourceName, "service_account", "terraform-acc-test-account@spotinst-labs.iam.gserviceaccount.com"),
 //					resource.TestCheckResourceAttr(resourceName, "startup_script", "echo hello world updated"),
-//					resource.TestCheckResourceAttr(resourceName, "shutdown_script", elastigroup_gcp_launch_configuration.Base64StateFunc("echo goodbye world updated")),
+//					resource.TestCheckResourceAttr(resourceName, "shutdown_script", commons.Base64StateFunc("echo goodbye world updated")),
 //					resource.TestCheckResourceAttr(resourceName, "backend_services.#", "2"),
 //					resource.TestCheckResourceAttr(resourceName, "backend_services."+BackendSvcHash1_update+".service_name", "terraform-bs-dont-delete"),
 //					resource.TestCheckResourceAttr(resourceName, "backend_services."+BackendSvcHash1_update+".named_ports.#", "1"),
@@ -435,7 +435,7 @@ const testInstanceTypesGCPGroupConfig_Update = `
 //					testCheckElastigroupGCPAttributes(&group, groupName),
 //					resource.TestCheckResourceAttr(resourceName, "service_account", "cannot set empty service account"),
 //					resource.TestCheckResourceAttr(resourceName, "startup_script", "cannot set empty startup script"),
-//					resource.TestCheckResourceAttr(resourceName, "shutdown_script", elastigroup_gcp_launch_configuration.Base64StateFunc("cannot set empty shutdown script")),
+//					resource.TestCheckResourceAttr(resourceName, "shutdown_script", commons.Base64StateFunc("cannot set empty shutdown script")),
 //					resource.TestCheckResourceAttr(resourceName, "ip_forwarding", "false"),
 //					resource.TestCheckResourceAttr(resourceName, "labels.#", "0"),
 //					resource.TestCheckResourceAttr(resourceName, "metadata.#", "0"),
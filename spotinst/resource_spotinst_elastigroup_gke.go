@@ -228,16 +228,16 @@ func resourceSpotinstElastigroupGKEUpdate(resourceData *schema.ResourceData, met
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.ElastigroupGKEResource.GetName(), groupId)
 
-	shouldUpdate, elastigroup, err := commons.ElastigroupGKEResource.OnUpdate(resourceData, meta)
+	changeset, elastigroup, err := commons.ElastigroupGKEResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		elastigroup.SetID(spotinst.String(groupId))
 
 		if err := updateGKEGroup(elastigroup, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
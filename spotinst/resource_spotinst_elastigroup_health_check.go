@@ -2,16 +2,18 @@ package spotinst
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
-	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/service/healthcheck"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/uritemplates"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/health_check"
 )
@@ -30,6 +32,8 @@ func resourceSpotinstHealthCheck() *schema.Resource {
 		},
 
 		Schema: commons.HealthCheckResource.GetSchemaMap(),
+
+		CustomizeDiff: health_check.ValidateCheck,
 	}
 }
 
@@ -69,23 +73,57 @@ func resourceSpotinstHealthCheckRead(resourceData *schema.ResourceData, meta int
 		return nil
 	}
 
-	if err := commons.HealthCheckResource.OnRead(HealthCheckResponse, resourceData, meta); err != nil {
+	// The health of the instances only fills the informational counters, so
+	// it is read on a best-effort basis and the counters are left unset when
+	// it cannot be read.
+	instancesHealth, err := readHealthCheckInstancesHealth(spotinst.StringValue(HealthCheckResponse.ResourceID), meta.(*Client))
+	if err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+
+	if err := commons.HealthCheckResource.OnRead(HealthCheckResponse, instancesHealth, resourceData, meta); err != nil {
 		return err
 	}
 	log.Printf("===> HealthCheck read successfully: %s <===", resourceId)
 	return nil
 }
 
+// readHealthCheckInstancesHealth counts the healthy and unhealthy instances of
+// the checked resource. The health of the instances is only reported for
+// Elastigroups, nil is returned for other resources.
+func readHealthCheckInstancesHealth(resourceID string, spotinstClient *Client) (*commons.HealthCheckInstancesHealth, error) {
+	if !strings.HasPrefix(resourceID, "sig-") {
+		return nil, nil
+	}
+
+	input := &aws.GetInstanceHealthinessInput{GroupID: spotinst.String(resourceID)}
+	resp, err := spotinstClient.elastigroup.CloudProviderAWS().GetInstanceHealthiness(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read instance healthiness of %s: %s", resourceID, err)
+	}
+
+	instancesHealth := &commons.HealthCheckInstancesHealth{}
+	for _, instance := range resp.Instances {
+		switch spotinst.StringValue(instance.HealthStatus) {
+		case "HEALTHY":
+			instancesHealth.Healthy++
+		case "UNHEALTHY":
+			instancesHealth.Unhealthy++
+		}
+	}
+	return instancesHealth, nil
+}
+
 func resourceSpotinstHealthCheckCreate(resourceData *schema.ResourceData, meta interface{}) error {
 
 	log.Printf(string(commons.ResourceOnCreate), commons.HealthCheckResource.GetName())
 
-	healthCheck, err := commons.HealthCheckResource.OnCreate(resourceData, meta)
+	healthCheck, checkOptions, err := commons.HealthCheckResource.OnCreate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	healthCheckId, err := createHealthCheck(resourceData, healthCheck, meta.(*Client))
+	healthCheckId, err := createHealthCheck(resourceData, healthCheck, checkOptions, meta.(*Client))
 	if err != nil {
 		return err
 	}
@@ -98,77 +136,160 @@ func resourceSpotinstHealthCheckCreate(resourceData *schema.ResourceData, meta i
 
 }
 
-func createHealthCheck(resourceData *schema.ResourceData, healthCheck *healthcheck.HealthCheck, spotinstClient *Client) (*string, error) {
-	if json, err := commons.ToJson(healthCheck); err != nil {
+func createHealthCheck(resourceData *schema.ResourceData, healthCheck *healthcheck.HealthCheck,
+	checkOptions *commons.HealthCheckOptions, spotinstClient *Client) (*string, error) {
+	if checkOptions.IsEmpty() {
+		if json, err := commons.ToJson(healthCheck); err != nil {
+			return nil, err
+		} else {
+			log.Printf("===> HealthCheck create configuration: %s", json)
+		}
+
+		input := &healthcheck.CreateHealthCheckInput{HealthCheck: healthCheck}
+		resp, err := spotinstClient.healthCheck.Create(context.Background(), input)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] failed to create HealthCheck: %s", err)
+		}
+		return resp.HealthCheck.ID, nil
+	}
+
+	body, err := healthCheckRequestBody(healthCheck, checkOptions)
+	if err != nil {
+		return nil, err
+	}
+	if json, err := commons.ToJson(body); err != nil {
 		return nil, err
 	} else {
 		log.Printf("===> HealthCheck create configuration: %s", json)
 	}
-	var resp *healthcheck.CreateHealthCheckOutput = nil
-	err := resource.Retry(time.Minute, func() *resource.RetryError {
-		input := &healthcheck.CreateHealthCheckInput{HealthCheck: healthCheck}
-		r, err := spotinstClient.healthCheck.Create(context.Background(), input)
-		if err != nil {
-			// Checks whether we should retry the HealthCheck creation.
-			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
-				for _, err := range errs {
-					if err.Code == "InvalidParameterValue" &&
-						strings.Contains(err.Message, "Invalid IAM Instance Profile") {
-						return resource.RetryableError(err)
-					}
-				}
-			}
-			// Some other error, report it.
-			return resource.NonRetryableError(err)
-		}
-		resp = r
-		return nil
-	})
+
+	resp, err := sendHealthCheck(spotinstClient, http.MethodPost, "/healthCheck", body)
 	if err != nil {
 		return nil, fmt.Errorf("[ERROR] failed to create HealthCheck: %s", err)
 	}
-	return resp.HealthCheck.ID, nil
+	return resp.ID, nil
+}
+
+// healthCheckRequestBody returns the body of a create or update request with
+// check options. The options are merged into the check, since healthcheck.Check
+// has no fields for them. The request goes to the endpoints healthcheck.Service
+// Create and Update of spotinst-sdk-go use, POST /healthCheck and
+// PUT /healthCheck/{healthCheckId}.
+func healthCheckRequestBody(healthCheck *healthcheck.HealthCheck, checkOptions *commons.HealthCheckOptions) (map[string]interface{}, error) {
+	hc := make(map[string]interface{})
+	if err := remarshalJSON(healthCheck, &hc); err != nil {
+		return nil, err
+	}
+
+	if check, ok := hc["check"].(map[string]interface{}); ok && checkOptions != nil {
+		options := make(map[string]interface{})
+		if err := remarshalJSON(checkOptions, &options); err != nil {
+			return nil, err
+		}
+		for k, v := range options {
+			check[k] = v
+		}
+	}
+
+	return map[string]interface{}{"healthCheck": hc}, nil
+}
+
+// sendHealthCheck sends a create or update request with the given body and
+// returns the resulting HealthCheck.
+func sendHealthCheck(spotinstClient *Client, method, path string, body interface{}) (*healthcheck.HealthCheck, error) {
+	items, err := doAPIRequest(context.Background(), spotinstClient, method, path, nil, body)
+	if err != nil {
+		return nil, err
+	}
 
+	healthCheck := new(healthcheck.HealthCheck)
+	if len(items) > 0 {
+		if err := json.Unmarshal(items[0], healthCheck); err != nil {
+			return nil, err
+		}
+	}
+	return healthCheck, nil
+}
+
+func remarshalJSON(in, out interface{}) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
 }
 
 func resourceSpotinstHealthCheckUpdate(resourceData *schema.ResourceData, meta interface{}) error {
 	resourceId := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.HealthCheckResource.GetName(), resourceId)
 
-	shouldUpdate, healthCheck, err := commons.HealthCheckResource.OnUpdate(resourceData, meta)
+	changeset, healthCheck, checkOptions, err := commons.HealthCheckResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
-		healthCheck.SetId(spotinst.String(resourceId))
-		if err := updateHealthCheck(healthCheck, resourceData, meta); err != nil {
-			return err
+	if changeset.HasChanges() {
+		if err := updateHealthCheck(healthCheck, checkOptions, resourceData, meta); err != nil {
+			return changeset.WrapError(err)
 		}
 	}
 	log.Printf("===> HealthCheck updated successfully: %s <===", resourceId)
 	return resourceSpotinstHealthCheckRead(resourceData, meta)
 }
 
-func updateHealthCheck(healthCheck *healthcheck.HealthCheck, resourceData *schema.ResourceData, meta interface{}) error {
-	var input = &healthcheck.UpdateHealthCheckInput{
-		HealthCheck: healthCheck,
-	}
-
+func updateHealthCheck(healthCheck *healthcheck.HealthCheck, checkOptions *commons.HealthCheckOptions,
+	resourceData *schema.ResourceData, meta interface{}) error {
 	healthCheckId := resourceData.Id()
 
-	if json, err := commons.ToJson(healthCheck); err != nil {
+	// Options that were removed are cleared with null values, which only the
+	// request with check options sends.
+	if checkOptions.IsEmpty() && !hasCheckOptionsChange(resourceData) {
+		if json, err := commons.ToJson(healthCheck); err != nil {
+			return err
+		} else {
+			log.Printf("===> HealthCheck update configuration: %s", json)
+		}
+
+		input := &healthcheck.UpdateHealthCheckInput{HealthCheck: healthCheck}
+		if _, err := meta.(*Client).healthCheck.Update(context.Background(), input); err != nil {
+			return fmt.Errorf("[ERROR] Failed to update HealthCheck [%v]: %v", healthCheckId, err)
+		}
+		return nil
+	}
+
+	body, err := healthCheckRequestBody(healthCheck, checkOptions)
+	if err != nil {
+		return err
+	}
+	if json, err := commons.ToJson(body); err != nil {
 		return err
 	} else {
 		log.Printf("===> HealthCheck update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).healthCheck.Update(context.Background(), input); err != nil {
+	path, err := uritemplates.Expand("/healthCheck/{healthCheckId}", uritemplates.Values{
+		"healthCheckId": healthCheckId,
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := sendHealthCheck(meta.(*Client), http.MethodPut, path, body); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update HealthCheck [%v]: %v", healthCheckId, err)
 	}
 	return nil
 }
 
+// hasCheckOptionsChange reports whether any of the check options changed.
+func hasCheckOptionsChange(resourceData *schema.ResourceData) bool {
+	for _, field := range []commons.FieldName{health_check.Headers, health_check.ExpectedResponseBody, health_check.GracePeriod} {
+		if resourceData.HasChange(fmt.Sprintf("%s.0.%s", health_check.Check, field)) {
+			return true
+		}
+	}
+	return false
+}
+
 func resourceSpotinstHealthCheckDelete(resourceData *schema.ResourceData, meta interface{}) error {
 	resourceId := resourceData.Id()
 	log.Printf(string(commons.ResourceOnDelete), commons.HealthCheckResource.GetName(), resourceId)
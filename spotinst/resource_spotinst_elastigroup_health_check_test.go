@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -143,6 +144,8 @@ func TestAccSpotinstHealthCheckBaseline(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "check.0.protocol", "http"),
 					resource.TestCheckResourceAttr(resourceName, "check.0.timeout", "12"),
 					resource.TestCheckResourceAttr(resourceName, "check.0.unhealthy", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "healthy_instances"),
+					resource.TestCheckResourceAttrSet(resourceName, "unhealthy_instances"),
 				),
 			},
 			{
@@ -210,3 +213,138 @@ resource "` + string(commons.HealthCheckResourceName) + `" "%v" {
 `
 
 // endregion
+
+// region HealthCheck: TCP
+func TestAccSpotinstHealthCheck_TCP(t *testing.T) {
+	name := "test-acc-health_check_tcp"
+	resourceName := createHealthCheckResourceName(name)
+
+	var healthCheck healthcheck.HealthCheck
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testHealthCheckDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testTCPHealthCheckConfig, name, name, "endpoint = \"http://endpoint.com\""),
+				ExpectError: regexp.MustCompile("endpoint must not be set for protocol \"tcp\""),
+			},
+			{
+				Config: fmt.Sprintf(testTCPHealthCheckConfig, name, name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckHealthCheckExists(&healthCheck, resourceName),
+					testCheckHealthCheckAttributes(&healthCheck, name),
+					resource.TestCheckResourceAttr(resourceName, "check.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "check.0.port", "22"),
+					resource.TestCheckResourceAttr("data.spotinst_health_checks.foo", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.spotinst_health_checks.foo", "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttr("data.spotinst_health_checks.foo", "health_checks.0.protocol", "tcp"),
+				),
+			},
+		},
+	})
+}
+
+const testTCPHealthCheckConfig = `
+provider "aws" {
+ token   = "fake"
+ account = "fake"
+}
+
+resource "` + string(commons.HealthCheckResourceName) + `" "%v" {
+  provider      = "aws"
+  resource_id   = "sig-05d0a009"
+  name          = "%v"
+  proxy_address = "http://proxy.com"
+  proxy_port    = 80
+
+  check {
+    protocol  = "tcp"
+    port      = 22
+    interval  = 10
+    timeout   = 5
+    unhealthy = 2
+    healthy   = 2
+    %v
+  }
+}
+
+data "` + string(commons.HealthChecksDataSourceName) + `" "foo" {
+  provider    = "aws"
+  resource_id = "sig-05d0a009"
+  name        = "${spotinst_health_check.test-acc-health_check_tcp.name}"
+}
+`
+
+// endregion
+
+// region HealthCheck: Body Matching
+func TestAccSpotinstHealthCheck_BodyMatching(t *testing.T) {
+	name := "test-acc-health_check_body_matching"
+	resourceName := createHealthCheckResourceName(name)
+
+	var healthCheck healthcheck.HealthCheck
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testHealthCheckDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testTCPHealthCheckConfig, name, name, "expected_response_body = \"ok\""),
+				ExpectError: regexp.MustCompile("expected_response_body must not be set for protocol \"tcp\""),
+			},
+			{
+				Config: fmt.Sprintf(testBodyMatchingHealthCheckConfig, name, name),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckHealthCheckExists(&healthCheck, resourceName),
+					testCheckHealthCheckAttributes(&healthCheck, name),
+					resource.TestCheckResourceAttr(resourceName, "resource_id", "smi-5a1b6f8e"),
+					resource.TestCheckResourceAttr(resourceName, "check.0.headers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "check.0.headers.0.name", "Host"),
+					resource.TestCheckResourceAttr(resourceName, "check.0.headers.0.value", "app.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "check.0.expected_response_body", "\"status\":\"ok\""),
+					resource.TestCheckResourceAttr(resourceName, "check.0.grace_period", "120"),
+					resource.TestCheckResourceAttrSet(resourceName, "healthy_instances"),
+					resource.TestCheckResourceAttrSet(resourceName, "unhealthy_instances"),
+				),
+			},
+		},
+	})
+}
+
+const testBodyMatchingHealthCheckConfig = `
+provider "aws" {
+ token   = "fake"
+ account = "fake"
+}
+
+resource "` + string(commons.HealthCheckResourceName) + `" "%v" {
+  provider      = "aws"
+  resource_id   = "smi-5a1b6f8e"
+  name          = "%v"
+  proxy_address = "http://proxy.com"
+  proxy_port    = 80
+
+  check {
+    protocol  = "http"
+    port      = 80
+    endpoint  = "/health"
+    interval  = 10
+    timeout   = 5
+    unhealthy = 2
+    healthy   = 2
+
+    headers {
+      name  = "Host"
+      value = "app.example.com"
+    }
+
+    expected_response_body = "\"status\":\"ok\""
+    grace_period           = 120
+  }
+}
+`
+
+// endregion
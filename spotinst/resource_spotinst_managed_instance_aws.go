@@ -91,13 +91,29 @@ func resourceSpotinstManagedInstanceAWSRead(resourceData *schema.ResourceData, m
 		return nil
 	}
 
-	if err := commons.ManagedInstanceResource.OnRead(managedInstanceResponse, resourceData, meta); err != nil {
+	// The instance status is informational, failing to read it must not fail
+	// the refresh. The status attributes are then left unset.
+	instanceStatus, err := readManagedInstanceStatus(id, meta.(*Client))
+	if err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+
+	if err := commons.ManagedInstanceResource.OnRead(managedInstanceResponse, instanceStatus, resourceData, meta); err != nil {
 		return err
 	}
 	log.Printf("===> ManagedInstance read successfully: %s <===", id)
 	return nil
 }
 
+func readManagedInstanceStatus(id string, spotinstClient *Client) (*aws.StatusManagedInstanceOutput, error) {
+	input := &aws.StatusManagedInstanceInput{ManagedInstanceID: spotinst.String(id)}
+	status, err := spotinstClient.managedInstance.CloudProviderAWS().Status(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ManagedInstance status: %s", err)
+	}
+	return status, nil
+}
+
 func resourceSpotinstManagedInstanceAWSCreate(resourceData *schema.ResourceData, meta interface{}) error {
 	log.Printf(string(commons.ResourceOnCreate),
 		commons.ManagedInstanceResource.GetName())
@@ -116,9 +132,41 @@ func resourceSpotinstManagedInstanceAWSCreate(resourceData *schema.ResourceData,
 
 	log.Printf("===> ManagedInstance created successfully: %s <===", resourceData.Id())
 
+	if timeout, ok := resourceData.GetOk(string(managed_instance_aws.WaitForRunningTimeout)); ok && timeout.(int) > 0 {
+		if err := awaitManagedInstanceRunning(resourceData.Id(), timeout.(int), meta.(*Client)); err != nil {
+			return err
+		}
+	}
+
 	return resourceSpotinstManagedInstanceAWSRead(resourceData, meta)
 }
 
+// awaitManagedInstanceRunning waits until the underlying instance of the
+// managed instance is active and has been assigned a private IP.
+func awaitManagedInstanceRunning(id string, timeout int, spotinstClient *Client) error {
+	err := resource.Retry(time.Second*time.Duration(timeout), func() *resource.RetryError {
+		status, err := readManagedInstanceStatus(id, spotinstClient)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if !strings.EqualFold(spotinst.StringValue(status.Status), "active") ||
+			spotinst.StringValue(status.InstanceID) == "" ||
+			spotinst.StringValue(status.PrivateIP) == "" {
+			return resource.RetryableError(fmt.Errorf("instance of ManagedInstance %s is not running yet (status: %q)",
+				id, spotinst.StringValue(status.Status)))
+		}
+
+		log.Printf("===> ManagedInstance %s is running: %s <===", id, spotinst.StringValue(status.InstanceID))
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("[ERROR] failed to wait for ManagedInstance to be running: %s", err)
+	}
+	return nil
+}
+
 func createManagedInstance(resourceData *schema.ResourceData, mangedInstance *aws.ManagedInstance, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(mangedInstance); err != nil {
 		return nil, err
@@ -162,15 +210,15 @@ func resourceSpotinstManagedInstanceAWSUpdate(resourceData *schema.ResourceData,
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.ManagedInstanceResource.GetName(), id)
 
-	shouldUpdate, managedInstance, err := commons.ManagedInstanceResource.OnUpdate(resourceData, meta)
+	changeset, managedInstance, err := commons.ManagedInstanceResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		managedInstance.SetId(spotinst.String(id))
 		if err := updateAWSManagedInstance(managedInstance, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
@@ -298,6 +346,34 @@ func deleteManagedInstance(resourceData *schema.ResourceData, meta interface{})
 			ShouldDeleteNetworkInterfaces: spotinst.Bool(true),
 		},
 	}
+
+	if statefulDeallocation, exists := resourceData.GetOk(string(managed_instance_persistence.StatefulDeallocation)); exists {
+		list := statefulDeallocation.([]interface{})
+		if len(list) > 0 && list[0] != nil {
+			m := list[0].(map[string]interface{})
+
+			if shouldDeleteImages, ok := m[string(managed_instance_persistence.ShouldDeleteImages)].(bool); ok {
+				input.AMIBackup.ShouldDeleteImages = spotinst.Bool(shouldDeleteImages)
+				input.DeallocationConfig.ShouldDeleteImages = spotinst.Bool(shouldDeleteImages)
+			}
+
+			if shouldDeleteNetworkInterfaces, ok := m[string(managed_instance_persistence.ShouldDeleteNetworkInterfaces)].(bool); ok {
+				input.DeallocationConfig.ShouldDeleteNetworkInterfaces = spotinst.Bool(shouldDeleteNetworkInterfaces)
+			}
+
+			if shouldDeleteVolumes, ok := m[string(managed_instance_persistence.ShouldDeleteVolumes)].(bool); ok {
+				input.DeallocationConfig.ShouldDeleteVolumes = spotinst.Bool(shouldDeleteVolumes)
+			}
+
+			if shouldDeleteSnapshots, ok := m[string(managed_instance_persistence.ShouldDeleteSnapshots)].(bool); ok {
+				input.DeallocationConfig.ShouldDeleteSnapshots = spotinst.Bool(shouldDeleteSnapshots)
+			}
+
+			if shouldTerminateInstance, ok := m[string(managed_instance_persistence.ShouldTerminateInstance)].(bool); ok {
+				input.DeallocationConfig.ShouldTerminateInstance = spotinst.Bool(shouldTerminateInstance)
+			}
+		}
+	}
 	if json, err := commons.ToJson(input); err != nil {
 		return err
 	} else {
@@ -12,7 +12,6 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
-	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_launch_configuration"
 )
 
 func init() {
@@ -261,6 +260,97 @@ resource "` + string(commons.ManagedInstanceAWSResourceName) + `" "%v" {
 
 // endregion
 
+// region ManagedInstance: Instance Status
+func TestAccSpotinstManagedInstanceInstanceStatus(t *testing.T) {
+	name := "test-acc-cluster-managed-instance-status"
+	resourceName := createManagedInstanceAWSResourceName(name)
+
+	var cluster aws.ManagedInstance
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testManagedInstanceAWSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createManagedInstanceTerraform(&ManagedInstanceConfigMetadata{
+					name:           name,
+					fieldsToAppend: managedInstanceInstanceStatus_Create,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckManagedInstanceAWSExists(&cluster, resourceName),
+					testCheckManagedInstanceAWSAttributes(&cluster, name),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_running_timeout", "600"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_private_ip"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+const managedInstanceInstanceStatus_Create = `
+ wait_for_running_timeout = 600
+`
+
+// endregion
+
+// region ManagedInstance: Persistence
+func TestAccSpotinstManagedInstancePersistence(t *testing.T) {
+	name := "test-acc-cluster-managed-instance-persistence"
+	resourceName := createManagedInstanceAWSResourceName(name)
+
+	var cluster aws.ManagedInstance
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testManagedInstanceAWSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createManagedInstanceTerraform(&ManagedInstanceConfigMetadata{
+					name:           name,
+					fieldsToAppend: managedInstancePersistence_Create,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckManagedInstanceAWSExists(&cluster, resourceName),
+					testCheckManagedInstanceAWSAttributes(&cluster, name),
+					resource.TestCheckResourceAttr(resourceName, "stateful_deallocation.0.should_delete_snapshots", "true"),
+					resource.TestCheckResourceAttr(resourceName, "stateful_deallocation.0.should_delete_volumes", "true"),
+				),
+			},
+			{
+				Config: createManagedInstanceTerraform(&ManagedInstanceConfigMetadata{
+					name:           name,
+					fieldsToAppend: managedInstancePersistence_Update,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckManagedInstanceAWSExists(&cluster, resourceName),
+					testCheckManagedInstanceAWSAttributes(&cluster, name),
+					resource.TestCheckResourceAttr(resourceName, "stateful_deallocation.0.should_delete_snapshots", "false"),
+					resource.TestCheckResourceAttr(resourceName, "stateful_deallocation.0.should_delete_images", "false"),
+				),
+			},
+		},
+	})
+}
+
+const managedInstancePersistence_Create = `
+ stateful_deallocation {
+  should_delete_snapshots = true
+ }
+`
+
+const managedInstancePersistence_Update = `
+ stateful_deallocation {
+  should_delete_snapshots = false
+  should_delete_images = false
+ }
+`
+
+// endregion
+
 // region ManagedInstance: Strategy
 func TestAccSpotinstManagedInstanceStrategy(t *testing.T) {
 	name := "test-acc-cluster-managed-instance-strategy"
@@ -443,8 +533,8 @@ func TestAccSpotinstManagedInstanceCompute(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tags.2594194374.value", "value1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.2281712832.key", "explicit2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.2281712832.value", "value2"),
-					resource.TestCheckResourceAttr(resourceName, "user_data", elastigroup_aws_launch_configuration.Base64StateFunc("echo hello world")),
-					resource.TestCheckResourceAttr(resourceName, "shutdown_script", elastigroup_aws_launch_configuration.Base64StateFunc("echo goodbye world")),
+					resource.TestCheckResourceAttr(resourceName, "user_data", commons.UserDataStateFunc("echo hello world")),
+					resource.TestCheckResourceAttr(resourceName, "shutdown_script", commons.Base64StateFunc("echo goodbye world")),
 					resource.TestCheckResourceAttr(resourceName, "cpu_credits", "standard"),
 					resource.TestCheckResourceAttr(resourceName, "network_interface.1006920623.device_index", "0"),
 					resource.TestCheckResourceAttr(resourceName, "network_interface.1006920623.associate_public_ip_address", "false"),
@@ -482,8 +572,8 @@ func TestAccSpotinstManagedInstanceCompute(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.2916442246.key", "explicit1-update"),
 					resource.TestCheckResourceAttr(resourceName, "tags.2916442246.value", "value1-update"),
-					resource.TestCheckResourceAttr(resourceName, "user_data", elastigroup_aws_launch_configuration.Base64StateFunc("echo hello world updated")),
-					resource.TestCheckResourceAttr(resourceName, "shutdown_script", elastigroup_aws_launch_configuration.Base64StateFunc("echo goodbye world updated")),
+					resource.TestCheckResourceAttr(resourceName, "user_data", commons.UserDataStateFunc("echo hello world updated")),
+					resource.TestCheckResourceAttr(resourceName, "shutdown_script", commons.Base64StateFunc("echo goodbye world updated")),
 					resource.TestCheckResourceAttr(resourceName, "cpu_credits", "unlimited"),
 					resource.TestCheckResourceAttr(resourceName, "network_interface.3418395336.device_index", "1"),
 					resource.TestCheckResourceAttr(resourceName, "network_interface.3418395336.associate_public_ip_address", "true"),
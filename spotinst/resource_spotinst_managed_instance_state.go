@@ -0,0 +1,138 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons/managed_instance_state"
+)
+
+func resourceSpotinstManagedInstanceState() *schema.Resource {
+	setupManagedInstanceStateResource()
+
+	return &schema.Resource{
+		Create: resourceSpotinstManagedInstanceStateCreate,
+		Read:   resourceSpotinstManagedInstanceStateRead,
+		Update: resourceSpotinstManagedInstanceStateUpdate,
+		Delete: deleteInstanceState(commons.ManagedInstanceStateResource.GetName()),
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: commons.ManagedInstanceStateResource.GetSchemaMap(),
+	}
+}
+
+func setupManagedInstanceStateResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	managed_instance_state.Setup(fieldsMap)
+
+	commons.ManagedInstanceStateResource = commons.NewManagedInstanceStateResource(fieldsMap)
+}
+
+func resourceSpotinstManagedInstanceStateCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.ManagedInstanceStateResource.GetName())
+
+	state, err := commons.ManagedInstanceStateResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	// The ID is only set once the state is reached, so that a failed
+	// transition is run again by the next apply.
+	if err := convergeManagedInstanceState(state, meta.(*Client)); err != nil {
+		return err
+	}
+
+	resourceData.SetId(spotinst.StringValue(state.ManagedInstanceID))
+	log.Printf("===> ManagedInstance state created successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstManagedInstanceStateRead(resourceData, meta)
+}
+
+func resourceSpotinstManagedInstanceStateRead(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.ManagedInstanceStateResource.GetName(), id)
+
+	managedInstance, err := readManagedInstanceAWS(id, meta.(*Client))
+	if err != nil {
+		return err
+	}
+
+	// If the managed instance is gone, so is its state.
+	if managedInstance == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	status, err := readManagedInstanceStatus(id, meta.(*Client))
+	if err != nil {
+		return err
+	}
+
+	state := &commons.ManagedInstanceStateSpec{
+		ManagedInstanceID: spotinst.String(id),
+		State:             instanceStateFromStatus(status.Status),
+		Status:            status.Status,
+	}
+
+	if err := commons.ManagedInstanceStateResource.OnRead(state, resourceData, meta); err != nil {
+		return err
+	}
+
+	log.Printf("===> ManagedInstance state read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstManagedInstanceStateUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.ManagedInstanceStateResource.GetName(), resourceData.Id())
+
+	changeset, state, err := commons.ManagedInstanceStateResource.OnUpdate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	if err := updateInstanceState(resourceData, changeset, func() error {
+		return convergeManagedInstanceState(state, meta.(*Client))
+	}); err != nil {
+		return err
+	}
+
+	log.Printf("===> ManagedInstance state updated successfully: %s <===", resourceData.Id())
+	return resourceSpotinstManagedInstanceStateRead(resourceData, meta)
+}
+
+// convergeManagedInstanceState pauses or resumes the managed instance to reach
+// the desired state.
+func convergeManagedInstanceState(state *commons.ManagedInstanceStateSpec, client *Client) error {
+	if json, err := commons.ToJson(state); err != nil {
+		return err
+	} else {
+		log.Printf("===> ManagedInstance state configuration: %s", json)
+	}
+
+	id := spotinst.StringValue(state.ManagedInstanceID)
+	svc := client.managedInstance.CloudProviderAWS()
+
+	return convergeInstanceState(spotinst.StringValue(state.State), spotinst.IntValue(state.Timeout), instanceStateFuncs{
+		name: fmt.Sprintf("ManagedInstance %s", id),
+		read: func() (*string, error) {
+			status, err := readManagedInstanceStatus(id, client)
+			if err != nil {
+				return nil, err
+			}
+			return status.Status, nil
+		},
+		pause:  func() error { return pauseManagedInstance(context.Background(), svc, id) },
+		resume: func() error { return resumeManagedInstance(context.Background(), svc, id) },
+	})
+}
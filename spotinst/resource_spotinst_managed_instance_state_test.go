@@ -0,0 +1,88 @@
+package spotinst
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createManagedInstanceStateResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.ManagedInstanceStateResourceName), name)
+}
+
+type ManagedInstanceStateConfigMetadata struct {
+	provider string
+	name     string
+	state    string
+}
+
+func createManagedInstanceStateTerraform(mscm *ManagedInstanceStateConfigMetadata) string {
+	if mscm == nil {
+		return ""
+	}
+
+	if mscm.provider == "" {
+		mscm.provider = "aws"
+	}
+
+	template := createManagedInstanceTerraform(&ManagedInstanceConfigMetadata{
+		provider: mscm.provider,
+		name:     mscm.name,
+	})
+
+	template += fmt.Sprintf(testBaselineManagedInstanceStateConfig,
+		mscm.name,
+		mscm.provider,
+		createManagedInstanceAWSResourceName(mscm.name),
+		mscm.state,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", mscm.name, template)
+	return template
+}
+
+func TestAccSpotinstManagedInstanceState_Baseline(t *testing.T) {
+	name := "test-acc-managed-instance-state"
+	resourceName := createManagedInstanceStateResourceName(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testManagedInstanceAWSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createManagedInstanceStateTerraform(&ManagedInstanceStateConfigMetadata{
+					name:  name,
+					state: "paused",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "paused"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				Config: createManagedInstanceStateTerraform(&ManagedInstanceStateConfigMetadata{
+					name:  name,
+					state: "running",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "running"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineManagedInstanceStateConfig = `
+resource "` + string(commons.ManagedInstanceStateResourceName) + `" "%v" {
+  provider            = "%v"
+  managed_instance_id = "${%v.id}"
+  state               = "%v"
+  timeout             = 900
+}
+`
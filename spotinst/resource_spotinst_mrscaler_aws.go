@@ -2,8 +2,10 @@ package spotinst
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/mrscaler"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/uritemplates"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/mrscaler_aws"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/mrscaler_aws_cluster"
@@ -35,7 +38,8 @@ func resourceSpotinstMRScalerAWS() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		Schema: commons.MRScalerAWSResource.GetSchemaMap(),
+		Schema:        commons.MRScalerAWSResource.GetSchemaMap(),
+		CustomizeDiff: mrscaler_aws.ValidateStrategy,
 	}
 }
 
@@ -62,6 +66,13 @@ func resourceSpotinstMRScalerAWSCreate(resourceData *schema.ResourceData, meta i
 		return err
 	}
 
+	// A cluster wrapped without a task group is linked as is.
+	if resourceData.Get(string(mrscaler_aws_strategy.Strategy)).(string) == mrscaler_aws_strategy.Wrap {
+		if _, ok := resourceData.GetOk(string(mrscaler_aws_instance_groups.TaskInstanceTypes)); !ok {
+			scaler.Compute = nil
+		}
+	}
+
 	scalerId, err := createScaler(scaler, meta.(*Client))
 	if err != nil {
 		return err
@@ -71,9 +82,64 @@ func resourceSpotinstMRScalerAWSCreate(resourceData *schema.ResourceData, meta i
 
 	log.Printf("===> MRScaler created successfully: %s <===", resourceData.Id())
 
+	if resourceData.Get(string(mrscaler_aws.WaitForSteps)).(bool) {
+		timeout := resourceData.Get(string(mrscaler_aws.WaitForStepsTimeout)).(int)
+		if err := awaitMRScalerAWSClusterSteps(resourceData.Id(), timeout, meta.(*Client)); err != nil {
+			return err
+		}
+	}
+
 	return resourceSpotinstMRScalerAWSRead(resourceData, meta)
 }
 
+// awaitMRScalerAWSClusterSteps waits until the cluster of the scaler is up and
+// all of its steps have completed, and fails as soon as a step or the cluster
+// fails. Failed reads are retried until the timeout.
+func awaitMRScalerAWSClusterSteps(id string, timeout int, spotinstClient *Client) error {
+	err := resource.Retry(time.Second*time.Duration(timeout), func() *resource.RetryError {
+		cluster, err := readMRScalerAWSCluster(id, spotinstClient)
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+
+		state := strings.ToUpper(spotinst.StringValue(cluster.State))
+		switch state {
+		case "TERMINATING", "TERMINATED", "TERMINATED_WITH_ERRORS":
+			return resource.NonRetryableError(fmt.Errorf("cluster %s of MRScaler %s is %s",
+				spotinst.StringValue(cluster.ID), id, state))
+		case "RUNNING", "WAITING":
+		default:
+			return resource.RetryableError(fmt.Errorf("cluster of MRScaler %s is not running yet (state: %q)", id, state))
+		}
+
+		for _, step := range cluster.Steps {
+			status := strings.ToUpper(spotinst.StringValue(step.Status))
+			switch status {
+			case "COMPLETED":
+			case "FAILED", "CANCELLED", "INTERRUPTED":
+				return resource.NonRetryableError(fmt.Errorf("step %q of MRScaler %s is %s",
+					spotinst.StringValue(step.Name), id, status))
+			default:
+				return resource.RetryableError(fmt.Errorf("step %q of MRScaler %s has not finished yet (status: %q)",
+					spotinst.StringValue(step.Name), id, status))
+			}
+		}
+
+		if len(cluster.Steps) == 0 {
+			log.Printf("[WARN] cluster of MRScaler %s reported no steps, nothing to wait for", id)
+			return nil
+		}
+
+		log.Printf("===> MRScaler %s steps finished: %d <===", id, len(cluster.Steps))
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("[ERROR] failed to wait for MRScaler steps: %s", err)
+	}
+	return nil
+}
+
 func createScaler(scaler *mrscaler.Scaler, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(scaler); err != nil {
 		return nil, err
@@ -128,13 +194,28 @@ func resourceSpotinstMRScalerAWSRead(resourceData *schema.ResourceData, meta int
 		return nil
 	}
 
-	if exist := resourceData.Get(string(mrscaler_aws.ExposeClusterID)).(bool); exist {
-		if err := exposeMrScalerClusterId(resourceData, meta); err != nil {
+	// A cluster wrapped without a task group has no compute configuration.
+	if scalerResponse.Compute == nil {
+		scalerResponse.SetCompute(&mrscaler.Compute{})
+	}
+
+	// The cluster is read on a best-effort basis, it may not be up yet.
+	exposeClusterID := resourceData.Get(string(mrscaler_aws.ExposeClusterID)).(bool)
+	cluster, err := readMRScalerAWSCluster(id, meta.(*Client))
+	if err != nil {
+		if exposeClusterID {
+			return fmt.Errorf("failed reading cloned cluster id of mr scaler : %s", err)
+		}
+		log.Printf("[WARN] failed to read cluster of MRScaler %s: %s", id, err)
+	}
+
+	if exposeClusterID {
+		if err := exposeMrScalerClusterId(resourceData, cluster); err != nil {
 			return err
 		}
 	}
 
-	if err := commons.MRScalerAWSResource.OnRead(scalerResponse, resourceData, meta); err != nil {
+	if err := commons.MRScalerAWSResource.OnRead(scalerResponse, cluster, resourceData, meta); err != nil {
 		return err
 	}
 
@@ -147,15 +228,15 @@ func resourceSpotinstMRScalerAWSUpdate(resourceData *schema.ResourceData, meta i
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.MRScalerAWSResource.GetName(), id)
 
-	shouldUpdate, scaler, err := commons.MRScalerAWSResource.OnUpdate(resourceData, meta)
+	changeset, scaler, err := commons.MRScalerAWSResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		scaler.SetId(spotinst.String(id))
 		if err := updateScaler(scaler, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
@@ -214,20 +295,38 @@ func deleteScaler(resourceData *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func exposeMrScalerClusterId(resourceData *schema.ResourceData, meta interface{}) error {
-	spotinstClient := meta.(*Client)
-	input := &mrscaler.ScalerClusterStatusInput{ScalerID: spotinst.String(resourceData.Id())}
-	resp, err := spotinstClient.mrscaler.ReadScalerCluster(context.Background(), input)
+func exposeMrScalerClusterId(resourceData *schema.ResourceData, cluster *commons.MRScalerAWSClusterStatus) error {
+	if cluster != nil && cluster.ID != nil {
+		if err := resourceData.Set(string(mrscaler_aws.OutputClusterID), cluster.ID); err != nil {
+			return err
+		}
+	}
 
+	return nil
+}
+
+// readMRScalerAWSCluster reads the cluster of the scaler from the endpoint
+// ReadScalerCluster of spotinst-sdk-go calls. The SDK only decodes the cluster
+// ID, so the request is sent as is; the state and steps are left unset when
+// the response does not include them.
+func readMRScalerAWSCluster(id string, spotinstClient *Client) (*commons.MRScalerAWSClusterStatus, error) {
+	path, err := uritemplates.Expand("/aws/emr/mrScaler/{mrScalerId}/cluster", uritemplates.Values{
+		"mrScalerId": id,
+	})
 	if err != nil {
-		return fmt.Errorf("failed reading cloned cluster id of mr scaler : %s", err)
+		return nil, err
 	}
 
-	if resp.ScalerClusterId != nil {
-		if err = resourceData.Set(string(mrscaler_aws.OutputClusterID), resp.ScalerClusterId); err != nil {
-			return err
-		}
+	items, err := doAPIRequest(context.Background(), spotinstClient, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	cluster := new(commons.MRScalerAWSClusterStatus)
+	if len(items) > 0 {
+		if err := json.Unmarshal(items[0], cluster); err != nil {
+			return nil, err
+		}
+	}
+	return cluster, nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -418,3 +419,140 @@ tags {
 `
 
 // endregion
+
+// region MRScalerAWS: Strategy
+func createMRScalerAWSStrategyTerraform(scalerName string, format string) string {
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+	template += fmt.Sprintf(format, scalerName, "aws")
+
+	log.Printf("Terraform [%v] template:\n%v", scalerName, template)
+	return template
+}
+
+func TestAccSpotinstMRScalerAWS_StrategyValidation(t *testing.T) {
+	scalerName := "mrscaler-strategy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t, "aws") },
+		Providers: TestAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config:      createMRScalerAWSStrategyTerraform(scalerName, testMRScalerAWSStrategy_WrapWithoutCluster),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`cluster_id is required when strategy is "wrap"`),
+			},
+			{
+				Config:      createMRScalerAWSStrategyTerraform(scalerName, testMRScalerAWSStrategy_WrapWithCoreGroup),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`core_instance_types cannot be set when strategy is "wrap"`),
+			},
+			{
+				Config:      createMRScalerAWSStrategyTerraform(scalerName, testMRScalerAWSStrategy_CloneWithReleaseLabel),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`release_label cannot be set when strategy is "clone"`),
+			},
+			{
+				Config:      createMRScalerAWSStrategyTerraform(scalerName, testMRScalerAWSStrategy_NewWithoutReleaseLabel),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`release_label is required when strategy is "new"`),
+			},
+		},
+	})
+}
+
+const testMRScalerAWSStrategy_WrapWithoutCluster = `
+resource "` + string(commons.MRScalerAWSResourceName) + `" "%v" {
+ provider = "%v"
+ name     = "test-acc-strategy"
+ region   = "us-east-1"
+ strategy = "wrap"
+}
+`
+
+const testMRScalerAWSStrategy_WrapWithCoreGroup = `
+resource "` + string(commons.MRScalerAWSResourceName) + `" "%v" {
+ provider   = "%v"
+ name       = "test-acc-strategy"
+ region     = "us-east-1"
+ strategy   = "wrap"
+ cluster_id = "j-27UVDEHXL4OQM"
+
+ core_instance_types = ["c3.xlarge"]
+}
+`
+
+const testMRScalerAWSStrategy_CloneWithReleaseLabel = `
+resource "` + string(commons.MRScalerAWSResourceName) + `" "%v" {
+ provider      = "%v"
+ name          = "test-acc-strategy"
+ region        = "us-east-1"
+ strategy      = "clone"
+ cluster_id    = "j-27UVDEHXL4OQM"
+ release_label = "emr-5.0.3"
+}
+`
+
+const testMRScalerAWSStrategy_NewWithoutReleaseLabel = `
+resource "` + string(commons.MRScalerAWSResourceName) + `" "%v" {
+ provider = "%v"
+ name     = "test-acc-strategy"
+ region   = "us-east-1"
+ strategy = "new"
+
+ master_instance_types = ["c3.xlarge"]
+ core_instance_types   = ["c3.xlarge"]
+}
+`
+
+// endregion
+
+// region MRScalerAWS: Cluster
+func TestAccSpotinstMRScalerAWS_Cluster(t *testing.T) {
+	scalerName := "mrscaler-cluster"
+	resourceName := createMRScalerAWSResourceName(scalerName)
+
+	var scaler mrscaler.Scaler
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testMRScalerAWSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createMRScalerAWSStrategyTerraform(scalerName, testMRScalerAWSCluster_WaitForSteps),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckMRScalerAWSExists(&scaler, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_steps", "true"),
+					resource.TestCheckResourceAttr(resourceName, "output_cluster_id", "j-27UVDEHXL4OQM"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_state"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_public_dns"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_instance_group_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "core_instance_group_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "steps.#"),
+				),
+			},
+		},
+	})
+}
+
+const testMRScalerAWSCluster_WaitForSteps = `
+resource "` + string(commons.MRScalerAWSResourceName) + `" "%v" {
+ provider   = "%v"
+ name       = "test-acc-cluster"
+ region     = "us-east-1"
+ strategy   = "wrap"
+ cluster_id = "j-27UVDEHXL4OQM"
+
+ expose_cluster_id      = true
+ wait_for_steps         = true
+ wait_for_steps_timeout = 1800
+}
+`
+
+// endregion
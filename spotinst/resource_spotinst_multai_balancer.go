@@ -114,15 +114,15 @@ func resourceSpotinstMultaiBalancerUpdate(resourceData *schema.ResourceData, met
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.MultaiBalancerResource.GetName(), balancerId)
 
-	shouldUpdate, balancer, err := commons.MultaiBalancerResource.OnUpdate(resourceData, meta)
+	changeset, balancer, err := commons.MultaiBalancerResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		balancer.SetId(spotinst.String(balancerId))
 		if err := updateBalancer(balancer, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
@@ -0,0 +1,180 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/multai_certificate"
+)
+
+func resourceSpotinstMultaiCertificate() *schema.Resource {
+	setupMultaiCertificateResource()
+
+	return &schema.Resource{
+		Create: resourceSpotinstMultaiCertificateCreate,
+		Read:   resourceSpotinstMultaiCertificateRead,
+		Update: resourceSpotinstMultaiCertificateUpdate,
+		Delete: resourceSpotinstMultaiCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: commons.MultaiCertificateResource.GetSchemaMap(),
+
+		CustomizeDiff: multai_certificate.ValidateCertificate,
+	}
+}
+
+func setupMultaiCertificateResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	multai_certificate.Setup(fieldsMap)
+
+	commons.MultaiCertificateResource = commons.NewMultaiCertificateResource(fieldsMap)
+}
+
+func resourceSpotinstMultaiCertificateCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.MultaiCertificateResource.GetName())
+
+	certificate, err := commons.MultaiCertificateResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	certificateId, err := createMultaiCertificate(certificate, meta.(*Client))
+	if err != nil {
+		return err
+	}
+
+	resourceData.SetId(spotinst.StringValue(certificateId))
+	log.Printf("===> Certificate created successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstMultaiCertificateRead(resourceData, meta)
+}
+
+func createMultaiCertificate(certificate *multai.Certificate, spotinstClient *Client) (*string, error) {
+	if json, err := commons.ToJson(certificate); err != nil {
+		return nil, err
+	} else {
+		log.Printf("===> Certificate create configuration: %s", json)
+	}
+
+	var resp *multai.CreateCertificateOutput = nil
+	err := resource.Retry(time.Minute, func() *resource.RetryError {
+		input := &multai.CreateCertificateInput{Certificate: certificate}
+		r, err := spotinstClient.multai.CreateCertificate(context.Background(), input)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		resp = r
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] failed to create certificate: %s", err)
+	}
+
+	return resp.Certificate.ID, nil
+}
+
+func resourceSpotinstMultaiCertificateRead(resourceData *schema.ResourceData, meta interface{}) error {
+	certificateId := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.MultaiCertificateResource.GetName(), certificateId)
+
+	input := &multai.ReadCertificateInput{CertificateID: spotinst.String(certificateId)}
+	resp, err := meta.(*Client).multai.ReadCertificate(context.Background(), input)
+	if err != nil {
+		return fmt.Errorf("failed to read certificate: %s", err)
+	}
+
+	// If nothing was found, return no state
+	certResponse := resp.Certificate
+	if certResponse == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	if err := commons.MultaiCertificateResource.OnRead(certResponse, resourceData, meta); err != nil {
+		return err
+	}
+
+	log.Printf("===> Certificate read successfully: %s <===", certificateId)
+	return nil
+}
+
+func resourceSpotinstMultaiCertificateUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	certificateId := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.MultaiCertificateResource.GetName(), certificateId)
+
+	changeset, certificate, err := commons.MultaiCertificateResource.OnUpdate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	if changeset.HasChanges() {
+		certificate.ID = spotinst.String(certificateId)
+		if err := updateMultaiCertificate(certificate, resourceData, meta); err != nil {
+			return changeset.WrapError(err)
+		}
+	}
+
+	log.Printf("===> Certificate updated successfully: %s <===", certificateId)
+	return resourceSpotinstMultaiCertificateRead(resourceData, meta)
+}
+
+func updateMultaiCertificate(certificate *multai.Certificate, resourceData *schema.ResourceData, meta interface{}) error {
+	var input = &multai.UpdateCertificateInput{Certificate: certificate}
+	certificateId := resourceData.Id()
+
+	if json, err := commons.ToJson(certificate); err != nil {
+		return err
+	} else {
+		log.Printf("===> Certificate update configuration: %s", json)
+	}
+
+	if _, err := meta.(*Client).multai.UpdateCertificate(context.Background(), input); err != nil {
+		return fmt.Errorf("[ERROR] Failed to update certificate [%v]: %v", certificateId, err)
+	}
+
+	return nil
+}
+
+func resourceSpotinstMultaiCertificateDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	certificateId := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.MultaiCertificateResource.GetName(), certificateId)
+
+	if err := deleteMultaiCertificate(resourceData, meta); err != nil {
+		return err
+	}
+
+	log.Printf("===> Certificate deleted successfully: %s <===", resourceData.Id())
+	resourceData.SetId("")
+	return nil
+}
+
+func deleteMultaiCertificate(resourceData *schema.ResourceData, meta interface{}) error {
+	certificateId := resourceData.Id()
+	input := &multai.DeleteCertificateInput{CertificateID: spotinst.String(certificateId)}
+
+	if json, err := commons.ToJson(input); err != nil {
+		return err
+	} else {
+		log.Printf("===> Certificate delete configuration: %s", json)
+	}
+
+	if _, err := meta.(*Client).multai.DeleteCertificate(context.Background(), input); err != nil {
+		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete certificate: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package spotinst
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math/big"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createMultaiCertificateResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.MultaiCertificateResourceName), name)
+}
+
+func testAccCheckSpotinstMultaiCertificateDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.MultaiCertificateResourceName) {
+			continue
+		}
+		input := &multai.ReadCertificateInput{
+			CertificateID: spotinst.String(rs.Primary.ID),
+		}
+		resp, err := client.multai.ReadCertificate(context.Background(), input)
+		if err == nil && resp != nil && resp.Certificate != nil {
+			return fmt.Errorf("certificate still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckSpotinstMultaiCertificateExists(certificate *multai.Certificate, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		client := testAccProviderAWS.Meta().(*Client)
+		input := &multai.ReadCertificateInput{
+			CertificateID: spotinst.String(rs.Primary.ID),
+		}
+		resp, err := client.multai.ReadCertificate(context.Background(), input)
+		if err != nil {
+			return err
+		}
+		if spotinst.StringValue(resp.Certificate.ID) != rs.Primary.Attributes["id"] {
+			return fmt.Errorf("certificate not found: %+v,\n %+v\n", resp.Certificate, rs.Primary.Attributes)
+		}
+		*certificate = *resp.Certificate
+		return nil
+	}
+}
+
+// testAccCheckSpotinstMultaiCertificateRotated checks that the certificate
+// was updated in place, keeping its ID.
+func testAccCheckSpotinstMultaiCertificateRotated(before, after *multai.Certificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if spotinst.StringValue(before.ID) != spotinst.StringValue(after.ID) {
+			return fmt.Errorf("certificate was recreated: %s != %s",
+				spotinst.StringValue(before.ID), spotinst.StringValue(after.ID))
+		}
+		return nil
+	}
+}
+
+// testAccMultaiCertificatePEM returns a self-signed certificate for the host
+// name and its private key.
+func testAccMultaiCertificatePEM(t *testing.T, hostName string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: hostName},
+		DNSNames:     []string{hostName, "www." + hostName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+type CertificateConfigMetadata struct {
+	provider       string
+	name           string
+	certificatePEM string
+	privateKeyPEM  string
+}
+
+func createCertificateTerraform(ccm *CertificateConfigMetadata) string {
+	if ccm == nil {
+		return ""
+	}
+
+	if ccm.provider == "" {
+		ccm.provider = "aws"
+	}
+
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	template += fmt.Sprintf(testBaselineCertificateConfig,
+		ccm.name,
+		ccm.provider,
+		ccm.name,
+		ccm.certificatePEM,
+		ccm.privateKeyPEM,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", ccm.name, template)
+	return template
+}
+
+func TestAccSpotinstMultaiCertificate_Baseline(t *testing.T) {
+	certificateName := "test-acc-certificate-baseline"
+	resourceName := createMultaiCertificateResourceName(certificateName)
+
+	certPEM, keyPEM := testAccMultaiCertificatePEM(t, "example.com")
+	rotatedCertPEM, rotatedKeyPEM := testAccMultaiCertificatePEM(t, "example.org")
+
+	var certificate, rotated multai.Certificate
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testAccCheckSpotinstMultaiCertificateDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createCertificateTerraform(&CertificateConfigMetadata{
+					name:           certificateName,
+					certificatePEM: certPEM,
+					privateKeyPEM:  rotatedKeyPEM,
+				}),
+				ExpectError: regexp.MustCompile("private_key_pem does not match certificate_pem"),
+			},
+			{
+				Config: createCertificateTerraform(&CertificateConfigMetadata{
+					name:           certificateName,
+					certificatePEM: certPEM,
+					privateKeyPEM:  keyPEM,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSpotinstMultaiCertificateExists(&certificate, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", certificateName),
+					resource.TestCheckResourceAttr(resourceName, "subject", "CN=example.com"),
+					resource.TestCheckResourceAttr(resourceName, "dns_names.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "dns_names.0", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "dns_names.1", "www.example.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_after"),
+				),
+			},
+			{
+				Config: createCertificateTerraform(&CertificateConfigMetadata{
+					name:           certificateName,
+					certificatePEM: rotatedCertPEM,
+					privateKeyPEM:  rotatedKeyPEM,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSpotinstMultaiCertificateExists(&rotated, resourceName),
+					testAccCheckSpotinstMultaiCertificateRotated(&certificate, &rotated),
+					resource.TestCheckResourceAttr(resourceName, "subject", "CN=example.org"),
+					resource.TestCheckResourceAttr(resourceName, "dns_names.0", "example.org"),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineCertificateConfig = `
+resource "` + string(commons.MultaiCertificateResourceName) + `" "%v" {
+  provider = "%v"
+  name     = "%v"
+
+  certificate_pem = <<EOF
+%vEOF
+
+  private_key_pem = <<EOF
+%vEOF
+
+  tags {
+    key   = "env"
+    value = "test"
+  }
+}`
@@ -114,15 +114,15 @@ func resourceSpotinstMultaiDeploymentUpdate(resourceData *schema.ResourceData, m
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.MultaiDeploymentResource.GetName(), deploymentId)
 
-	shouldUpdate, deployment, err := commons.MultaiDeploymentResource.OnUpdate(resourceData, meta)
+	changeset, deployment, err := commons.MultaiDeploymentResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		deployment.SetId(spotinst.String(deploymentId))
 		if err := updateDeployment(deployment, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
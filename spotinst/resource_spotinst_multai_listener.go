@@ -28,6 +28,8 @@ func resourceSpotinstMultaiListener() *schema.Resource {
 		},
 
 		Schema: commons.MultaiListenerResource.GetSchemaMap(),
+
+		CustomizeDiff: multai_listener.ValidateTLSConfig,
 	}
 }
 
@@ -114,15 +116,15 @@ func resourceSpotinstMultaiListenerUpdate(resourceData *schema.ResourceData, met
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.MultaiListenerResource.GetName(), listenerId)
 
-	shouldUpdate, listener, err := commons.MultaiListenerResource.OnUpdate(resourceData, meta)
+	changeset, listener, err := commons.MultaiListenerResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		listener.SetId(spotinst.String(listenerId))
 		if err := updateListener(listener, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -144,6 +145,50 @@ func TestAccSpotinstMultaiListener_Baseline(t *testing.T) {
 	})
 }
 
+func TestAccSpotinstMultaiListener_TLSConfig(t *testing.T) {
+	listenerName := "test-acc-listener-tls"
+	resourceName := createMultaiListenerResourceName(listenerName)
+	certPEM, keyPEM := testAccMultaiCertificatePEM(t, "example.com")
+
+	tlsConfig := func(minVersion, maxVersion, cipherSuite string) string {
+		template := `provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+		template += fmt.Sprintf(testTLSConfigListenerConfig,
+			certPEM, keyPEM, listenerName, minVersion, maxVersion, cipherSuite)
+		log.Printf("Terraform [%v] template:\n%v", listenerName, template)
+		return template
+	}
+
+	var listener multai.Listener
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testAccCheckSpotinstMultaiListenerDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config:      tlsConfig("TLS12", "TLS10", "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"),
+				ExpectError: regexp.MustCompile("min_version \\(TLS12\\) must not be higher than max_version \\(TLS10\\)"),
+			},
+			{
+				Config:      tlsConfig("TLS10", "TLS12", "TLS_ECDHE_ECDSA_WITH_AES_128_GCM"),
+				ExpectError: regexp.MustCompile("expected tls_config.*cipher_suites.0 to be one of"),
+			},
+			{
+				Config: tlsConfig("tls11", "TLS12", "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSpotinstMultaiListenerExists(&listener, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "HTTPS"),
+					resource.TestCheckResourceAttr(resourceName, "tls_config.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 const (
 	ListenerTagsHash_Create = "2538041064"
 	ListenerTagsHash_Update = "1968254376"
@@ -213,3 +258,41 @@ resource "` + string(commons.MultaiListenerResourceName) + `" "%v" {
    value = "updated"
   }
 }`
+
+const testTLSConfigListenerConfig = `
+resource "spotinst_multai_balancer" "foo" {
+  provider = "aws"
+  name = "foo"
+
+  connection_timeouts {
+    idle     = 10
+    draining = 10
+  }
+}
+
+resource "spotinst_multai_certificate" "foo" {
+  provider = "aws"
+  name     = "test-acc-listener-tls"
+
+  certificate_pem = <<EOF
+%vEOF
+
+  private_key_pem = <<EOF
+%vEOF
+}
+
+resource "` + string(commons.MultaiListenerResourceName) + `" "%v" {
+  provider    = "aws"
+  balancer_id = "${spotinst_multai_balancer.foo.id}"
+  protocol    = "https"
+  port        = 443
+
+  tls_config {
+    certificate_ids             = ["${spotinst_multai_certificate.foo.id}"]
+    min_version                 = "%v"
+    max_version                 = "%v"
+    cipher_suites               = ["%v"]
+    prefer_server_cipher_suites = true
+    session_tickets_disabled    = false
+  }
+}`
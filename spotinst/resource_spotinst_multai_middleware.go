@@ -0,0 +1,178 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/multai_middleware"
+)
+
+func resourceSpotinstMultaiMiddleware() *schema.Resource {
+	setupMultaiMiddlewareResource()
+
+	return &schema.Resource{
+		Create: resourceSpotinstMultaiMiddlewareCreate,
+		Read:   resourceSpotinstMultaiMiddlewareRead,
+		Update: resourceSpotinstMultaiMiddlewareUpdate,
+		Delete: resourceSpotinstMultaiMiddlewareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: commons.MultaiMiddlewareResource.GetSchemaMap(),
+	}
+}
+
+func setupMultaiMiddlewareResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	multai_middleware.Setup(fieldsMap)
+
+	commons.MultaiMiddlewareResource = commons.NewMultaiMiddlewareResource(fieldsMap)
+}
+
+func resourceSpotinstMultaiMiddlewareCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.MultaiMiddlewareResource.GetName())
+
+	middleware, err := commons.MultaiMiddlewareResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	middlewareId, err := createMultaiMiddleware(middleware, meta.(*Client))
+	if err != nil {
+		return err
+	}
+
+	resourceData.SetId(spotinst.StringValue(middlewareId))
+	log.Printf("===> Middleware created successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstMultaiMiddlewareRead(resourceData, meta)
+}
+
+func createMultaiMiddleware(middleware *multai.Middleware, spotinstClient *Client) (*string, error) {
+	if json, err := commons.ToJson(middleware); err != nil {
+		return nil, err
+	} else {
+		log.Printf("===> Middleware create configuration: %s", json)
+	}
+
+	var resp *multai.CreateMiddlewareOutput = nil
+	err := resource.Retry(time.Minute, func() *resource.RetryError {
+		input := &multai.CreateMiddlewareInput{Middleware: middleware}
+		r, err := spotinstClient.multai.CreateMiddleware(context.Background(), input)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		resp = r
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] failed to create middleware: %s", err)
+	}
+
+	return resp.Middleware.ID, nil
+}
+
+func resourceSpotinstMultaiMiddlewareRead(resourceData *schema.ResourceData, meta interface{}) error {
+	middlewareId := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.MultaiMiddlewareResource.GetName(), middlewareId)
+
+	input := &multai.ReadMiddlewareInput{MiddlewareID: spotinst.String(middlewareId)}
+	resp, err := meta.(*Client).multai.ReadMiddleware(context.Background(), input)
+	if err != nil {
+		return fmt.Errorf("failed to read middleware: %s", err)
+	}
+
+	// If nothing was found, return no state
+	middlewareResponse := resp.Middleware
+	if middlewareResponse == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	if err := commons.MultaiMiddlewareResource.OnRead(middlewareResponse, resourceData, meta); err != nil {
+		return err
+	}
+
+	log.Printf("===> Middleware read successfully: %s <===", middlewareId)
+	return nil
+}
+
+func resourceSpotinstMultaiMiddlewareUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	middlewareId := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.MultaiMiddlewareResource.GetName(), middlewareId)
+
+	changeset, middleware, err := commons.MultaiMiddlewareResource.OnUpdate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	if changeset.HasChanges() {
+		middleware.SetId(spotinst.String(middlewareId))
+		if err := updateMultaiMiddleware(middleware, resourceData, meta); err != nil {
+			return changeset.WrapError(err)
+		}
+	}
+
+	log.Printf("===> Middleware updated successfully: %s <===", middlewareId)
+	return resourceSpotinstMultaiMiddlewareRead(resourceData, meta)
+}
+
+func updateMultaiMiddleware(middleware *multai.Middleware, resourceData *schema.ResourceData, meta interface{}) error {
+	var input = &multai.UpdateMiddlewareInput{Middleware: middleware}
+	middlewareId := resourceData.Id()
+
+	if json, err := commons.ToJson(middleware); err != nil {
+		return err
+	} else {
+		log.Printf("===> Middleware update configuration: %s", json)
+	}
+
+	if _, err := meta.(*Client).multai.UpdateMiddleware(context.Background(), input); err != nil {
+		return fmt.Errorf("[ERROR] Failed to update middleware [%v]: %v", middlewareId, err)
+	}
+
+	return nil
+}
+
+func resourceSpotinstMultaiMiddlewareDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	middlewareId := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.MultaiMiddlewareResource.GetName(), middlewareId)
+
+	if err := deleteMultaiMiddleware(resourceData, meta); err != nil {
+		return err
+	}
+
+	log.Printf("===> Middleware deleted successfully: %s <===", resourceData.Id())
+	resourceData.SetId("")
+	return nil
+}
+
+func deleteMultaiMiddleware(resourceData *schema.ResourceData, meta interface{}) error {
+	middlewareId := resourceData.Id()
+	input := &multai.DeleteMiddlewareInput{MiddlewareID: spotinst.String(middlewareId)}
+
+	if json, err := commons.ToJson(input); err != nil {
+		return err
+	} else {
+		log.Printf("===> Middleware delete configuration: %s", json)
+	}
+
+	if _, err := meta.(*Client).multai.DeleteMiddleware(context.Background(), input); err != nil {
+		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete middleware: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createMultaiMiddlewareResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.MultaiMiddlewareResourceName), name)
+}
+
+func testAccCheckSpotinstMultaiMiddlewareDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.MultaiMiddlewareResourceName) {
+			continue
+		}
+		input := &multai.ReadMiddlewareInput{
+			MiddlewareID: spotinst.String(rs.Primary.ID),
+		}
+		resp, err := client.multai.ReadMiddleware(context.Background(), input)
+		if err == nil && resp != nil && resp.Middleware != nil {
+			return fmt.Errorf("middleware still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckSpotinstMultaiMiddlewareExists(middleware *multai.Middleware, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		client := testAccProviderAWS.Meta().(*Client)
+		input := &multai.ReadMiddlewareInput{
+			MiddlewareID: spotinst.String(rs.Primary.ID),
+		}
+		resp, err := client.multai.ReadMiddleware(context.Background(), input)
+		if err != nil {
+			return err
+		}
+		if spotinst.StringValue(resp.Middleware.ID) != rs.Primary.Attributes["id"] {
+			return fmt.Errorf("middleware not found: %+v,\n %+v\n", resp.Middleware, rs.Primary.Attributes)
+		}
+		*middleware = *resp.Middleware
+		return nil
+	}
+}
+
+type MiddlewareConfigMetadata struct {
+	provider string
+	name     string
+	priority int
+	spec     string
+}
+
+func createMiddlewareTerraform(mcm *MiddlewareConfigMetadata) string {
+	if mcm == nil {
+		return ""
+	}
+
+	if mcm.provider == "" {
+		mcm.provider = "aws"
+	}
+
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	template += fmt.Sprintf(testBaselineMiddlewareConfig,
+		mcm.name,
+		mcm.provider,
+		mcm.priority,
+		mcm.spec,
+		mcm.name,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", mcm.name, template)
+	return template
+}
+
+func TestAccSpotinstMultaiMiddleware_Baseline(t *testing.T) {
+	middlewareName := "test-acc-middleware-baseline"
+	resourceName := createMultaiMiddlewareResourceName(middlewareName)
+
+	var middleware multai.Middleware
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testAccCheckSpotinstMultaiMiddlewareDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createMiddlewareTerraform(&MiddlewareConfigMetadata{
+					name:     middlewareName,
+					priority: 1,
+					spec:     `{"headers": {"X-Env": "test"}}`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSpotinstMultaiMiddlewareExists(&middleware, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "HEADERS"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec", `{"headers":{"X-Env":"test"}}`),
+					resource.TestCheckResourceAttrPair("spotinst_multai_routing_rule.foo", "middleware_ids.0", resourceName, "id"),
+				),
+			},
+			{
+				Config: createMiddlewareTerraform(&MiddlewareConfigMetadata{
+					name:     middlewareName,
+					priority: 2,
+					spec:     `{"headers": {"X-Env": "updated"}}`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSpotinstMultaiMiddlewareExists(&middleware, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec", `{"headers":{"X-Env":"updated"}}`),
+				),
+			},
+		},
+	})
+}
+
+const MiddlewareRoute = "\"PathPrefix(\x60/api\x60) && Method(\x60GET\x60, \x60POST\x60)\""
+
+const testBaselineMiddlewareConfig = `
+resource "spotinst_multai_balancer" "foo" {
+  provider = "aws"
+  name     = "test-acc-foo"
+
+  connection_timeouts {
+    idle     = 10
+    draining = 10
+  }
+}
+
+resource "spotinst_multai_target_set" "foo" {
+  provider      = "aws"
+  balancer_id   = "${spotinst_multai_balancer.foo.id}"
+  deployment_id = "dp-12345"
+  name          = "test-acc-bar"
+  protocol      = "http"
+  port          = 1338
+  weight        = 2
+
+  health_check {
+    protocol            = "http"
+    path                = "/"
+    port                = 3001
+    interval            = 20
+    timeout             = 5
+    healthy_threshold   = 3
+    unhealthy_threshold = 3
+  }
+}
+
+resource "spotinst_multai_listener" "foo" {
+  provider    = "aws"
+  balancer_id = "${spotinst_multai_balancer.foo.id}"
+  protocol    = "http"
+  port        = 1338
+}
+
+resource "` + string(commons.MultaiMiddlewareResourceName) + `" "%v" {
+  provider    = "%v"
+  balancer_id = "${spotinst_multai_balancer.foo.id}"
+  type        = "HEADERS"
+  priority    = %v
+
+  spec = <<EOF
+%v
+EOF
+}
+
+resource "spotinst_multai_routing_rule" "foo" {
+  provider       = "aws"
+  balancer_id    = "${spotinst_multai_balancer.foo.id}"
+  listener_id    = "${spotinst_multai_listener.foo.id}"
+  route          = ` + MiddlewareRoute + `
+  middleware_ids = ["${spotinst_multai_middleware.%v.id}"]
+  target_set_ids = ["${spotinst_multai_target_set.foo.id}"]
+}`
@@ -28,6 +28,8 @@ func resourceSpotinstMultaiRoutingRule() *schema.Resource {
 		},
 
 		Schema: commons.MultaiRoutingRuleResource.GetSchemaMap(),
+
+		CustomizeDiff: resourceSpotinstMultaiRoutingRuleCustomizeDiff,
 	}
 }
 
@@ -48,6 +50,13 @@ func resourceSpotinstMultaiRoutingRuleCreate(resourceData *schema.ResourceData,
 		return err
 	}
 
+	// Checked again since rules created by the same apply were not known when
+	// the plan was made.
+	if err := checkMultaiRoutingRulePriority(resourceData.Id(), spotinst.StringValue(routingRule.BalancerID),
+		spotinst.StringValue(routingRule.ListenerID), spotinst.IntValue(routingRule.Priority), meta.(*Client)); err != nil {
+		return err
+	}
+
 	routingRuleId, err := createRoutingRule(routingRule, meta.(*Client))
 	if err != nil {
 		return err
@@ -83,6 +92,47 @@ func createRoutingRule(routingRule *multai.RoutingRule, spotinstClient *Client)
 	return resp.RoutingRule.ID, nil
 }
 
+// resourceSpotinstMultaiRoutingRuleCustomizeDiff rejects at plan time a
+// priority that is already used by another routing rule of the listener.
+func resourceSpotinstMultaiRoutingRuleCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" &&
+		!diff.HasChange(string(multai_routing_rule.Priority)) &&
+		!diff.HasChange(string(multai_routing_rule.ListenerID)) {
+		return nil
+	}
+
+	// The balancer and the listener may not be known yet, e.g. when they are
+	// created by the same plan.
+	balancerID := diff.Get(string(multai_routing_rule.BalancerID)).(string)
+	listenerID := diff.Get(string(multai_routing_rule.ListenerID)).(string)
+	if balancerID == "" || listenerID == "" {
+		return nil
+	}
+
+	return checkMultaiRoutingRulePriority(diff.Id(), balancerID, listenerID,
+		diff.Get(string(multai_routing_rule.Priority)).(int), meta.(*Client))
+}
+
+func checkMultaiRoutingRulePriority(routingRuleId, balancerID, listenerID string, priority int, spotinstClient *Client) error {
+	input := &multai.ListRoutingRulesInput{BalancerID: spotinst.String(balancerID)}
+	resp, err := spotinstClient.multai.ListRoutingRules(context.Background(), input)
+	if err != nil {
+		return fmt.Errorf("failed to list routing rules of balancer %s: %s", balancerID, err)
+	}
+
+	for _, rule := range resp.RoutingRules {
+		if spotinst.StringValue(rule.ID) == routingRuleId ||
+			spotinst.StringValue(rule.ListenerID) != listenerID ||
+			spotinst.IntValue(rule.Priority) != priority {
+			continue
+		}
+		return fmt.Errorf("%s %d is already used by routing rule %s (route %q) of listener %s",
+			string(multai_routing_rule.Priority), priority, spotinst.StringValue(rule.ID),
+			spotinst.StringValue(rule.Route), listenerID)
+	}
+	return nil
+}
+
 func resourceSpotinstMultaiRoutingRuleRead(resourceData *schema.ResourceData, meta interface{}) error {
 	routingRuleId := resourceData.Id()
 	log.Printf(string(commons.ResourceOnRead),
@@ -114,15 +164,24 @@ func resourceSpotinstMultaiRoutingRuleUpdate(resourceData *schema.ResourceData,
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.MultaiRoutingRuleResource.GetName(), routingRuleId)
 
-	shouldUpdate, routingRule, err := commons.MultaiRoutingRuleResource.OnUpdate(resourceData, meta)
+	changeset, routingRule, err := commons.MultaiRoutingRuleResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
+		if resourceData.HasChange(string(multai_routing_rule.Priority)) || resourceData.HasChange(string(multai_routing_rule.ListenerID)) {
+			if err := checkMultaiRoutingRulePriority(routingRuleId,
+				resourceData.Get(string(multai_routing_rule.BalancerID)).(string),
+				resourceData.Get(string(multai_routing_rule.ListenerID)).(string),
+				resourceData.Get(string(multai_routing_rule.Priority)).(int), meta.(*Client)); err != nil {
+				return changeset.WrapError(err)
+			}
+		}
+
 		routingRule.SetId(spotinst.String(routingRuleId))
 		if err := updateRoutingRule(routingRule, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
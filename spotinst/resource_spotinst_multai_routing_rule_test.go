@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -144,6 +145,40 @@ func TestAccSpotinstMultaiRoutingRule_Baseline(t *testing.T) {
 	})
 }
 
+func TestAccSpotinstMultaiRoutingRule_Validation(t *testing.T) {
+	config := func(route string) string {
+		template := `provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+		template += fmt.Sprintf(testValidationRoutingRuleConfig, route)
+		log.Printf("Terraform [routing-rule-validation] template:\n%v", template)
+		return template
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testAccCheckSpotinstMultaiRoutingRuleDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config:      config("PathRegex(`^/api/`)"),
+				ExpectError: regexp.MustCompile(`unknown matcher "PathRegex"`),
+			},
+			{
+				Config:      config("PathRegexp(`^/(api`) && Host(`foo.com`)"),
+				ExpectError: regexp.MustCompile(`invalid regular expression`),
+			},
+			{
+				Config:      config("PathRegexp(`^/api/`) && Host(`foo.com`)"),
+				ExpectError: regexp.MustCompile(`priority 1 is already used by routing rule`),
+			},
+		},
+	})
+}
+
 const (
 	RoutingRuleTagsHash_Create = "2538041064"
 	RoutingRuleTagsHash_Update = "1968254376"
@@ -281,3 +316,61 @@ resource "` + string(commons.MultaiRoutingRuleResourceName) + `" "%v" {
    value = "updated"
   }
 }`
+
+const testValidationRoutingRuleConfig = `
+resource "spotinst_multai_balancer" "foo" {
+  provider = "aws"
+  name     = "test-acc-foo"
+
+  connection_timeouts {
+    idle     = 10
+    draining = 10
+  }
+}
+
+resource "spotinst_multai_target_set" "foo" {
+  provider      = "aws"
+  balancer_id   = "${spotinst_multai_balancer.foo.id}"
+  deployment_id = "dp-12345"
+  name          = "test-acc-bar"
+  protocol      = "http"
+  port          = 1338
+  weight        = 2
+
+  health_check {
+    protocol            = "http"
+    path                = "/"
+    port                = 3001
+    interval            = 20
+    timeout             = 5
+    healthy_threshold   = 3
+    unhealthy_threshold = 3
+  }
+}
+
+resource "spotinst_multai_listener" "foo" {
+  provider    = "aws"
+  balancer_id = "${spotinst_multai_balancer.foo.id}"
+  protocol    = "http"
+  port        = 1338
+}
+
+resource "spotinst_multai_routing_rule" "first" {
+  provider       = "aws"
+  balancer_id    = "${spotinst_multai_balancer.foo.id}"
+  listener_id    = "${spotinst_multai_listener.foo.id}"
+  route          = ` + Path_Create + `
+  priority       = 1
+  target_set_ids = ["${spotinst_multai_target_set.foo.id}"]
+}
+
+resource "spotinst_multai_routing_rule" "second" {
+  provider       = "aws"
+  balancer_id    = "${spotinst_multai_balancer.foo.id}"
+  listener_id    = "${spotinst_multai_listener.foo.id}"
+  route          = "%v"
+  priority       = 1
+  target_set_ids = ["${spotinst_multai_target_set.foo.id}"]
+
+  depends_on = ["spotinst_multai_routing_rule.first"]
+}`
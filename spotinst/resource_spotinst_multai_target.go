@@ -114,15 +114,15 @@ func resourceSpotinstMultaiTargetUpdate(resourceData *schema.ResourceData, meta
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.MultaiTargetResource.GetName(), targetId)
 
-	shouldUpdate, target, err := commons.MultaiTargetResource.OnUpdate(resourceData, meta)
+	changeset, target, err := commons.MultaiTargetResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		target.SetId(spotinst.String(targetId))
 		if err := updateTarget(target, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
@@ -114,15 +114,15 @@ func resourceSpotinstMultaiTargetSetUpdate(resourceData *schema.ResourceData, me
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.MultaiTargetSetResource.GetName(), targetSetId)
 
-	shouldUpdate, targetSet, err := commons.MultaiTargetSetResource.OnUpdate(resourceData, meta)
+	changeset, targetSet, err := commons.MultaiTargetSetResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		targetSet.SetId(spotinst.String(targetSetId))
 		if err := updateTargetSet(targetSet, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
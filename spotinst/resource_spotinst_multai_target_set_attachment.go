@@ -0,0 +1,391 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	mi "github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/multai_target_set_attachment"
+)
+
+const (
+	multaiAttachmentKindElastigroup     = "elastigroup"
+	multaiAttachmentKindManagedInstance = "managed_instance"
+)
+
+// managedInstanceAWSMutexKV serializes read-modify-write cycles of resources
+// that manage a part of a managed instance by managed instance ID.
+var managedInstanceAWSMutexKV = mutexkv.NewMutexKV()
+
+func resourceSpotinstMultaiTargetSetAttachment() *schema.Resource {
+	setupMultaiTargetSetAttachmentResource()
+
+	return &schema.Resource{
+		Create: resourceSpotinstMultaiTargetSetAttachmentCreate,
+		Read:   resourceSpotinstMultaiTargetSetAttachmentRead,
+		Delete: resourceSpotinstMultaiTargetSetAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: commons.MultaiTargetSetAttachmentResource.GetSchemaMap(),
+	}
+}
+
+func setupMultaiTargetSetAttachmentResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	multai_target_set_attachment.Setup(fieldsMap)
+
+	commons.MultaiTargetSetAttachmentResource = commons.NewMultaiTargetSetAttachmentResource(fieldsMap)
+}
+
+func resourceSpotinstMultaiTargetSetAttachmentCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnCreate), commons.MultaiTargetSetAttachmentResource.GetName())
+
+	attachment, err := commons.MultaiTargetSetAttachmentResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	targetSetID := spotinst.StringValue(attachment.TargetSetID)
+	balancerID := spotinst.StringValue(attachment.BalancerID)
+
+	var id string
+	if attachment.ElastigroupID != nil {
+		id, err = attachElastigroupAWSTargetSet(spotinst.StringValue(attachment.ElastigroupID), targetSetID, balancerID, meta.(*Client))
+	} else {
+		id, err = attachManagedInstanceAWSTargetSet(spotinst.StringValue(attachment.ManagedInstanceID), targetSetID, balancerID, meta.(*Client))
+	}
+	if err != nil {
+		return err
+	}
+
+	resourceData.SetId(id)
+	log.Printf("===> Multai target set attachment created successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstMultaiTargetSetAttachmentRead(resourceData, meta)
+}
+
+func resourceSpotinstMultaiTargetSetAttachmentRead(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead), commons.MultaiTargetSetAttachmentResource.GetName(), id)
+
+	kind, ownerID, targetSetID, err := parseMultaiTargetSetAttachmentID(id)
+	if err != nil {
+		return err
+	}
+
+	attachment := &commons.MultaiTargetSetAttachmentSpec{
+		TargetSetID: spotinst.String(targetSetID),
+	}
+
+	switch kind {
+	case multaiAttachmentKindElastigroup:
+		group, err := readElastigroupAWS(ownerID, meta.(*Client))
+		if err != nil {
+			return err
+		}
+		balancer, _ := findElastigroupAWSTargetSet(getElastigroupAWSLoadBalancers(group), targetSetID)
+		if balancer == nil {
+			resourceData.SetId("")
+			return nil
+		}
+		attachment.ElastigroupID = spotinst.String(ownerID)
+		attachment.BalancerID = balancer.BalancerID
+	case multaiAttachmentKindManagedInstance:
+		managedInstance, err := readManagedInstanceAWS(ownerID, meta.(*Client))
+		if err != nil {
+			return err
+		}
+		balancer, _ := findManagedInstanceAWSTargetSet(getManagedInstanceAWSLoadBalancers(managedInstance), targetSetID)
+		if balancer == nil {
+			resourceData.SetId("")
+			return nil
+		}
+		attachment.ManagedInstanceID = spotinst.String(ownerID)
+		attachment.BalancerID = balancer.BalancerID
+	}
+
+	input := &multai.ListTargetsInput{
+		BalancerID:  attachment.BalancerID,
+		TargetSetID: attachment.TargetSetID,
+	}
+	resp, err := meta.(*Client).multai.ListTargets(context.Background(), input)
+	if err != nil {
+		return fmt.Errorf("failed to list targets of target set %s: %s", targetSetID, err)
+	}
+	attachment.Targets = resp.Targets
+
+	if err := commons.MultaiTargetSetAttachmentResource.OnRead(attachment, resourceData, meta); err != nil {
+		return err
+	}
+
+	log.Printf("===> Multai target set attachment read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstMultaiTargetSetAttachmentDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete), commons.MultaiTargetSetAttachmentResource.GetName(), id)
+
+	kind, ownerID, targetSetID, err := parseMultaiTargetSetAttachmentID(id)
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case multaiAttachmentKindElastigroup:
+		err = detachElastigroupAWSTargetSet(ownerID, targetSetID, meta.(*Client))
+	case multaiAttachmentKindManagedInstance:
+		err = detachManagedInstanceAWSTargetSet(ownerID, targetSetID, meta.(*Client))
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("===> Multai target set attachment deleted successfully: %s <===", id)
+	resourceData.SetId("")
+	return nil
+}
+
+func multaiTargetSetAttachmentID(kind, ownerID, targetSetID string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, ownerID, targetSetID)
+}
+
+func parseMultaiTargetSetAttachmentID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" ||
+		(parts[0] != multaiAttachmentKindElastigroup && parts[0] != multaiAttachmentKindManagedInstance) {
+		return "", "", "", fmt.Errorf("[ERROR] invalid target set attachment ID %q, expected "+
+			"elastigroup/<elastigroup_id>/<target_set_id> or managed_instance/<managed_instance_id>/<target_set_id>", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+func attachElastigroupAWSTargetSet(groupID, targetSetID, balancerID string, spotinstClient *Client) (string, error) {
+	elastigroupAWSMutexKV.Lock(groupID)
+	defer elastigroupAWSMutexKV.Unlock(groupID)
+
+	group, err := readElastigroupAWS(groupID, spotinstClient)
+	if err != nil {
+		return "", err
+	}
+	if group == nil {
+		return "", fmt.Errorf("[ERROR] Elastigroup %s does not exist", groupID)
+	}
+
+	balancers := getElastigroupAWSLoadBalancers(group)
+	if _, i := findElastigroupAWSTargetSet(balancers, targetSetID); i >= 0 {
+		return "", fmt.Errorf("[ERROR] target set %s is already attached to group %s, import it instead",
+			targetSetID, groupID)
+	}
+
+	balancer := &aws.LoadBalancer{
+		Type: spotinst.String(string(elastigroup_aws.BalancerTypeMultaiTargetSet)),
+	}
+	balancer.SetTargetSetId(spotinst.String(targetSetID))
+	balancer.SetBalancerId(spotinst.String(balancerID))
+
+	if err := updateElastigroupAWSLoadBalancers(groupID, append(balancers, balancer), spotinstClient); err != nil {
+		return "", err
+	}
+	return multaiTargetSetAttachmentID(multaiAttachmentKindElastigroup, groupID, targetSetID), nil
+}
+
+func detachElastigroupAWSTargetSet(groupID, targetSetID string, spotinstClient *Client) error {
+	elastigroupAWSMutexKV.Lock(groupID)
+	defer elastigroupAWSMutexKV.Unlock(groupID)
+
+	group, err := readElastigroupAWS(groupID, spotinstClient)
+	if err != nil {
+		return err
+	}
+
+	balancers := getElastigroupAWSLoadBalancers(group)
+	if _, i := findElastigroupAWSTargetSet(balancers, targetSetID); i >= 0 {
+		balancers = append(balancers[:i], balancers[i+1:]...)
+		return updateElastigroupAWSLoadBalancers(groupID, balancers, spotinstClient)
+	}
+	return nil
+}
+
+func getElastigroupAWSLoadBalancers(group *aws.Group) []*aws.LoadBalancer {
+	if group == nil || group.Compute == nil || group.Compute.LaunchSpecification == nil ||
+		group.Compute.LaunchSpecification.LoadBalancersConfig == nil {
+		return nil
+	}
+	return group.Compute.LaunchSpecification.LoadBalancersConfig.LoadBalancers
+}
+
+func findElastigroupAWSTargetSet(balancers []*aws.LoadBalancer, targetSetID string) (*aws.LoadBalancer, int) {
+	for i, balancer := range balancers {
+		if balancer != nil &&
+			spotinst.StringValue(balancer.Type) == string(elastigroup_aws.BalancerTypeMultaiTargetSet) &&
+			spotinst.StringValue(balancer.TargetSetID) == targetSetID {
+			return balancer, i
+		}
+	}
+	return nil, -1
+}
+
+// updateElastigroupAWSLoadBalancers replaces the load balancers of the group,
+// so the list must include the balancers not managed by the attachment.
+func updateElastigroupAWSLoadBalancers(groupID string, balancers []*aws.LoadBalancer, spotinstClient *Client) error {
+	// An empty list is omitted from the request, null clears it.
+	if len(balancers) == 0 {
+		balancers = nil
+	}
+
+	loadBalancersConfig := &aws.LoadBalancersConfig{}
+	loadBalancersConfig.SetLoadBalancers(balancers)
+
+	launchSpecification := &aws.LaunchSpecification{}
+	launchSpecification.SetLoadBalancersConfig(loadBalancersConfig)
+
+	compute := &aws.Compute{}
+	compute.SetLaunchSpecification(launchSpecification)
+
+	group := &aws.Group{}
+	group.SetId(spotinst.String(groupID))
+	group.SetCompute(compute)
+
+	if json, err := commons.ToJson(group); err != nil {
+		return err
+	} else {
+		log.Printf("===> Group load balancers update configuration: %s", json)
+	}
+
+	input := &aws.UpdateGroupInput{Group: group}
+	if _, err := spotinstClient.elastigroup.CloudProviderAWS().Update(context.Background(), input); err != nil {
+		return fmt.Errorf("[ERROR] Failed to update load balancers of group [%v]: %v", groupID, err)
+	}
+	return nil
+}
+
+func attachManagedInstanceAWSTargetSet(managedInstanceID, targetSetID, balancerID string, spotinstClient *Client) (string, error) {
+	managedInstanceAWSMutexKV.Lock(managedInstanceID)
+	defer managedInstanceAWSMutexKV.Unlock(managedInstanceID)
+
+	managedInstance, err := readManagedInstanceAWS(managedInstanceID, spotinstClient)
+	if err != nil {
+		return "", err
+	}
+	if managedInstance == nil {
+		return "", fmt.Errorf("[ERROR] managed instance %s does not exist", managedInstanceID)
+	}
+
+	balancers := getManagedInstanceAWSLoadBalancers(managedInstance)
+	if _, i := findManagedInstanceAWSTargetSet(balancers, targetSetID); i >= 0 {
+		return "", fmt.Errorf("[ERROR] target set %s is already attached to managed instance %s, import it instead",
+			targetSetID, managedInstanceID)
+	}
+
+	balancer := &mi.LoadBalancer{
+		Type:        spotinst.String(string(elastigroup_aws.BalancerTypeMultaiTargetSet)),
+		TargetSetID: spotinst.String(targetSetID),
+		BalancerID:  spotinst.String(balancerID),
+	}
+
+	if err := updateManagedInstanceAWSLoadBalancers(managedInstanceID, append(balancers, balancer), spotinstClient); err != nil {
+		return "", err
+	}
+	return multaiTargetSetAttachmentID(multaiAttachmentKindManagedInstance, managedInstanceID, targetSetID), nil
+}
+
+func detachManagedInstanceAWSTargetSet(managedInstanceID, targetSetID string, spotinstClient *Client) error {
+	managedInstanceAWSMutexKV.Lock(managedInstanceID)
+	defer managedInstanceAWSMutexKV.Unlock(managedInstanceID)
+
+	managedInstance, err := readManagedInstanceAWS(managedInstanceID, spotinstClient)
+	if err != nil {
+		return err
+	}
+
+	balancers := getManagedInstanceAWSLoadBalancers(managedInstance)
+	if _, i := findManagedInstanceAWSTargetSet(balancers, targetSetID); i >= 0 {
+		balancers = append(balancers[:i], balancers[i+1:]...)
+		return updateManagedInstanceAWSLoadBalancers(managedInstanceID, balancers, spotinstClient)
+	}
+	return nil
+}
+
+// readManagedInstanceAWS reads the managed instance that owns a standalone
+// part. A nil managed instance is returned when it does not exist anymore.
+func readManagedInstanceAWS(managedInstanceID string, spotinstClient *Client) (*mi.ManagedInstance, error) {
+	input := &mi.ReadManagedInstanceInput{ManagedInstanceID: spotinst.String(managedInstanceID)}
+	resp, err := spotinstClient.managedInstance.CloudProviderAWS().Read(context.Background(), input)
+	if err != nil {
+		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+			for _, err := range errs {
+				if err.Code == ErrCodeManagedInstanceDoesntExist {
+					return nil, nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("failed to read ManagedInstance: %s", err)
+	}
+	return resp.ManagedInstance, nil
+}
+
+func getManagedInstanceAWSLoadBalancers(managedInstance *mi.ManagedInstance) []*mi.LoadBalancer {
+	if managedInstance == nil || managedInstance.Integration == nil ||
+		managedInstance.Integration.LoadBalancersConfig == nil {
+		return nil
+	}
+	return managedInstance.Integration.LoadBalancersConfig.LoadBalancers
+}
+
+func findManagedInstanceAWSTargetSet(balancers []*mi.LoadBalancer, targetSetID string) (*mi.LoadBalancer, int) {
+	for i, balancer := range balancers {
+		if balancer != nil &&
+			spotinst.StringValue(balancer.Type) == string(elastigroup_aws.BalancerTypeMultaiTargetSet) &&
+			spotinst.StringValue(balancer.TargetSetID) == targetSetID {
+			return balancer, i
+		}
+	}
+	return nil, -1
+}
+
+// updateManagedInstanceAWSLoadBalancers replaces the load balancers of the
+// managed instance, so the list must include the balancers not managed by the
+// attachment.
+func updateManagedInstanceAWSLoadBalancers(managedInstanceID string, balancers []*mi.LoadBalancer, spotinstClient *Client) error {
+	// An empty list is omitted from the request, null clears it.
+	if len(balancers) == 0 {
+		balancers = nil
+	}
+
+	loadBalancersConfig := &mi.LoadBalancersConfig{}
+	loadBalancersConfig.SetLoadBalancers(balancers)
+
+	integration := &mi.Integration{}
+	integration.SetLoadBalancersConfig(loadBalancersConfig)
+
+	managedInstance := &mi.ManagedInstance{}
+	managedInstance.SetId(spotinst.String(managedInstanceID))
+	managedInstance.SetIntegration(integration)
+
+	if json, err := commons.ToJson(managedInstance); err != nil {
+		return err
+	} else {
+		log.Printf("===> ManagedInstance load balancers update configuration: %s", json)
+	}
+
+	input := &mi.UpdateManagedInstanceInput{ManagedInstance: managedInstance}
+	if _, err := spotinstClient.managedInstance.CloudProviderAWS().Update(context.Background(), input); err != nil {
+		return fmt.Errorf("[ERROR] Failed to update load balancers of managed instance [%v]: %v", managedInstanceID, err)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package spotinst
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createMultaiTargetSetAttachmentResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.MultaiTargetSetAttachmentResourceName), name)
+}
+
+func testAccCheckSpotinstMultaiTargetSetAttachmentDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.MultaiTargetSetAttachmentResourceName) {
+			continue
+		}
+		_, groupID, targetSetID, err := parseMultaiTargetSetAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		group, err := readElastigroupAWS(groupID, client)
+		if err != nil {
+			return err
+		}
+		if balancer, _ := findElastigroupAWSTargetSet(getElastigroupAWSLoadBalancers(group), targetSetID); balancer != nil {
+			return fmt.Errorf("target set attachment still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckSpotinstMultaiTargetSetAttachmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		_, groupID, targetSetID, err := parseMultaiTargetSetAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := testAccProviderAWS.Meta().(*Client)
+		group, err := readElastigroupAWS(groupID, client)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return fmt.Errorf("group not found: %s", groupID)
+		}
+		if balancer, _ := findElastigroupAWSTargetSet(getElastigroupAWSLoadBalancers(group), targetSetID); balancer == nil {
+			return fmt.Errorf("target set attachment not found: %+v", rs.Primary.Attributes)
+		}
+		return nil
+	}
+}
+
+type TargetSetAttachmentConfigMetadata struct {
+	provider string
+	name     string
+	groupID  string
+}
+
+func createTargetSetAttachmentTerraform(tsacm *TargetSetAttachmentConfigMetadata) string {
+	if tsacm == nil {
+		return ""
+	}
+
+	if tsacm.provider == "" {
+		tsacm.provider = "aws"
+	}
+
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	template += fmt.Sprintf(testBaselineTargetSetAttachmentConfig,
+		tsacm.name,
+		tsacm.provider,
+		tsacm.groupID,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", tsacm.name, template)
+	return template
+}
+
+func TestAccSpotinstMultaiTargetSetAttachment_Baseline(t *testing.T) {
+	groupID := "sig-05d0a009"
+	attachmentName := "test-acc-target-set-attachment"
+	resourceName := createMultaiTargetSetAttachmentResourceName(attachmentName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testAccCheckSpotinstMultaiTargetSetAttachmentDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createTargetSetAttachmentTerraform(&TargetSetAttachmentConfigMetadata{
+					name:    attachmentName,
+					groupID: groupID,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSpotinstMultaiTargetSetAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "elastigroup_id", groupID),
+					resource.TestCheckResourceAttrPair(resourceName, "target_set_id", "spotinst_multai_target_set.foo", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "balancer_id", "spotinst_multai_balancer.foo", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "targets.#"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"targets"},
+			},
+		},
+	})
+}
+
+const testBaselineTargetSetAttachmentConfig = `
+resource "spotinst_multai_balancer" "foo" {
+  provider = "aws"
+  name = "test-acc-foo"
+
+  connection_timeouts {
+    idle     = 10
+    draining = 10
+  }
+}
+
+resource "spotinst_multai_target_set" "foo" {
+  provider      = "aws"
+  name          = "test-acc-foo"
+  balancer_id   = "${spotinst_multai_balancer.foo.id}"
+  deployment_id = "dp-12345"
+  protocol      = "http"
+  port          = 1337
+  weight        = 1
+
+  health_check {
+    protocol            = "http"
+    path                = "/"
+    port                = 3000
+    interval            = 30
+    timeout             = 10
+    healthy_threshold   = 2
+    unhealthy_threshold = 2
+  }
+}
+
+resource "` + string(commons.MultaiTargetSetAttachmentResourceName) + `" "%v" {
+  provider       = "%v"
+  elastigroup_id = "%v"
+  target_set_id  = "${spotinst_multai_target_set.foo.id}"
+  balancer_id    = "${spotinst_multai_balancer.foo.id}"
+}`
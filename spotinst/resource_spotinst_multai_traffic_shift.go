@@ -0,0 +1,260 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/multai_traffic_shift"
+)
+
+func resourceSpotinstMultaiTrafficShift() *schema.Resource {
+	setupMultaiTrafficShiftResource()
+
+	return &schema.Resource{
+		Create: resourceSpotinstMultaiTrafficShiftCreate,
+		Read:   resourceSpotinstMultaiTrafficShiftRead,
+		Update: resourceSpotinstMultaiTrafficShiftUpdate,
+		Delete: resourceSpotinstMultaiTrafficShiftDelete,
+
+		Schema: commons.MultaiTrafficShiftResource.GetSchemaMap(),
+	}
+}
+
+func setupMultaiTrafficShiftResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	multai_traffic_shift.Setup(fieldsMap)
+
+	commons.MultaiTrafficShiftResource = commons.NewMultaiTrafficShiftResource(fieldsMap)
+}
+
+func resourceSpotinstMultaiTrafficShiftCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.MultaiTrafficShiftResource.GetName())
+
+	trafficShift, err := commons.MultaiTrafficShiftResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	// The ID is only set once the shift completed, so that a failed shift
+	// is run again by the next apply.
+	if err := shiftMultaiTraffic(trafficShift, meta.(*Client)); err != nil {
+		return err
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s:%s",
+		spotinst.StringValue(trafficShift.FromTargetSetID),
+		spotinst.StringValue(trafficShift.ToTargetSetID)))
+	log.Printf("===> Traffic shift completed successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstMultaiTrafficShiftRead(resourceData, meta)
+}
+
+func resourceSpotinstMultaiTrafficShiftRead(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnRead),
+		commons.MultaiTrafficShiftResource.GetName(), resourceData.Id())
+
+	client := meta.(*Client)
+	trafficShift := &commons.MultaiTrafficShiftSpec{
+		FromTargetSetID: spotinst.String(resourceData.Get(string(multai_traffic_shift.FromTargetSetID)).(string)),
+		ToTargetSetID:   spotinst.String(resourceData.Get(string(multai_traffic_shift.ToTargetSetID)).(string)),
+	}
+
+	fromSet, err := readMultaiTrafficShiftTargetSet(trafficShift.FromTargetSetID, client)
+	if err != nil {
+		return err
+	}
+	toSet, err := readMultaiTrafficShiftTargetSet(trafficShift.ToTargetSetID, client)
+	if err != nil {
+		return err
+	}
+
+	// If either target set is gone, there is nothing left to shift.
+	if fromSet == nil || toSet == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	trafficShift.FromWeight = fromSet.Weight
+	trafficShift.ToWeight = toSet.Weight
+
+	if err := commons.MultaiTrafficShiftResource.OnRead(trafficShift, resourceData, meta); err != nil {
+		return err
+	}
+
+	log.Printf("===> Traffic shift read successfully: %s <===", resourceData.Id())
+	return nil
+}
+
+func readMultaiTrafficShiftTargetSet(targetSetID *string, client *Client) (*multai.TargetSet, error) {
+	input := &multai.ReadTargetSetInput{TargetSetID: targetSetID}
+	resp, err := client.multai.ReadTargetSet(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read target set %s: %s", spotinst.StringValue(targetSetID), err)
+	}
+	return resp.TargetSet, nil
+}
+
+func resourceSpotinstMultaiTrafficShiftUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.MultaiTrafficShiftResource.GetName(), resourceData.Id())
+
+	changeset, trafficShift, err := commons.MultaiTrafficShiftResource.OnUpdate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	if changeset.HasChanges() {
+		// Keep the previous configuration in state if the shift fails, so
+		// that the next apply runs it again.
+		resourceData.Partial(true)
+		if err := shiftMultaiTraffic(trafficShift, meta.(*Client)); err != nil {
+			return changeset.WrapError(err)
+		}
+		resourceData.Partial(false)
+	}
+
+	log.Printf("===> Traffic shift updated successfully: %s <===", resourceData.Id())
+	return resourceSpotinstMultaiTrafficShiftRead(resourceData, meta)
+}
+
+func resourceSpotinstMultaiTrafficShiftDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.MultaiTrafficShiftResource.GetName(), resourceData.Id())
+
+	// The weights of the target sets are left as they are.
+	resourceData.SetId("")
+	return nil
+}
+
+// shiftMultaiTraffic applies the steps of the shift one after the other. After
+// each step the health of the targets of the target set shifted to is watched
+// for the step interval. If too many of them are unhealthy the shift stops,
+// and the weights are restored to their values before the shift if rollback is
+// enabled.
+func shiftMultaiTraffic(trafficShift *commons.MultaiTrafficShiftSpec, client *Client) error {
+	if json, err := commons.ToJson(trafficShift); err != nil {
+		return err
+	} else {
+		log.Printf("===> Traffic shift configuration: %s", json)
+	}
+
+	fromID, toID := trafficShift.FromTargetSetID, trafficShift.ToTargetSetID
+	fromSet, err := readMultaiTrafficShiftTargetSet(fromID, client)
+	if err != nil {
+		return err
+	}
+	toSet, err := readMultaiTrafficShiftTargetSet(toID, client)
+	if err != nil {
+		return err
+	}
+	if fromSet == nil || toSet == nil {
+		return fmt.Errorf("failed to shift traffic: target set %s or %s not found",
+			spotinst.StringValue(fromID), spotinst.StringValue(toID))
+	}
+	initialFromWeight, initialToWeight := spotinst.IntValue(fromSet.Weight), spotinst.IntValue(toSet.Weight)
+
+	for i, step := range trafficShift.Steps {
+		log.Printf("===> Traffic shift step %d/%d: %d%% to target set %s <===",
+			i+1, len(trafficShift.Steps), step, spotinst.StringValue(toID))
+
+		err := setMultaiTrafficShiftWeights(fromID, 100-step, toID, step, client)
+		if err == nil {
+			err = watchMultaiTargetSetHealth(trafficShift, client)
+		}
+		if err == nil {
+			continue
+		}
+
+		err = fmt.Errorf("traffic shift failed at step %d (%d%%): %v", i+1, step, err)
+		if !spotinst.BoolValue(trafficShift.Rollback) {
+			return err
+		}
+		log.Printf("===> Rolling back traffic shift to weights %d/%d <===", initialFromWeight, initialToWeight)
+		if rbErr := setMultaiTrafficShiftWeights(fromID, initialFromWeight, toID, initialToWeight, client); rbErr != nil {
+			return fmt.Errorf("%v, and rolling back the weights failed: %v", err, rbErr)
+		}
+		return fmt.Errorf("%v, the weights were rolled back", err)
+	}
+
+	return nil
+}
+
+func setMultaiTrafficShiftWeights(fromID *string, fromWeight int, toID *string, toWeight int, client *Client) error {
+	// The target set shifted to is updated first, so that the total weight of
+	// the two sets never drops to zero while shifting.
+	for _, tw := range []struct {
+		id     *string
+		weight int
+	}{{toID, toWeight}, {fromID, fromWeight}} {
+		targetSet := &multai.TargetSet{}
+		targetSet.SetId(tw.id)
+		targetSet.SetWeight(spotinst.Int(tw.weight))
+		input := &multai.UpdateTargetSetInput{TargetSet: targetSet}
+		if _, err := client.multai.UpdateTargetSet(context.Background(), input); err != nil {
+			return fmt.Errorf("failed to set the weight of target set %s to %d: %v",
+				spotinst.StringValue(tw.id), tw.weight, err)
+		}
+	}
+	return nil
+}
+
+// watchMultaiTargetSetHealth checks the health of the targets of the target
+// set shifted to every health check interval until the step interval elapsed.
+func watchMultaiTargetSetHealth(trafficShift *commons.MultaiTrafficShiftSpec, client *Client) error {
+	deadline := time.Now().Add(time.Duration(spotinst.IntValue(trafficShift.StepInterval)) * time.Second)
+	checkInterval := time.Duration(spotinst.IntValue(trafficShift.HealthCheckInterval)) * time.Second
+
+	for {
+		if err := checkMultaiTargetSetHealth(trafficShift.ToTargetSetID,
+			spotinst.IntValue(trafficShift.MaxUnhealthyPercentage), client); err != nil {
+			return err
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil
+		}
+		if remaining < checkInterval {
+			time.Sleep(remaining)
+		} else {
+			time.Sleep(checkInterval)
+		}
+	}
+}
+
+func checkMultaiTargetSetHealth(targetSetID *string, maxUnhealthyPercentage int, client *Client) error {
+	input := &multai.ListTargetsInput{TargetSetID: targetSetID}
+	resp, err := client.multai.ListTargets(context.Background(), input)
+	if err != nil {
+		return fmt.Errorf("failed to list targets of target set %s: %v", spotinst.StringValue(targetSetID), err)
+	}
+
+	if len(resp.Targets) == 0 {
+		return fmt.Errorf("target set %s has no targets", spotinst.StringValue(targetSetID))
+	}
+
+	unhealthy := 0
+	for _, target := range resp.Targets {
+		if target.Status != nil &&
+			spotinst.StringValue(target.Status.Healthiness) == multai.StatusUnhealthy.String() {
+			unhealthy++
+		}
+	}
+
+	log.Printf("===> Target set %s: %d of %d targets unhealthy <===",
+		spotinst.StringValue(targetSetID), unhealthy, len(resp.Targets))
+
+	if unhealthy*100 > maxUnhealthyPercentage*len(resp.Targets) {
+		return fmt.Errorf("%d of %d targets of target set %s are unhealthy, more than the allowed %d%%",
+			unhealthy, len(resp.Targets), spotinst.StringValue(targetSetID), maxUnhealthyPercentage)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package spotinst
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createMultaiTrafficShiftResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.MultaiTrafficShiftResourceName), name)
+}
+
+type TrafficShiftConfigMetadata struct {
+	provider string
+	name     string
+	steps    string
+}
+
+func createTrafficShiftTerraform(tscm *TrafficShiftConfigMetadata) string {
+	if tscm == nil {
+		return ""
+	}
+
+	if tscm.provider == "" {
+		tscm.provider = "aws"
+	}
+
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	template += fmt.Sprintf(testBaselineTrafficShiftConfig,
+		tscm.name,
+		tscm.provider,
+		tscm.steps,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", tscm.name, template)
+	return template
+}
+
+func TestAccSpotinstMultaiTrafficShift_Baseline(t *testing.T) {
+	trafficShiftName := "test-acc-traffic-shift-baseline"
+	resourceName := createMultaiTrafficShiftResourceName(trafficShiftName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t, "aws") },
+		Providers: TestAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createTrafficShiftTerraform(&TrafficShiftConfigMetadata{
+					name:  trafficShiftName,
+					steps: "[10, 50]",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "steps.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "from_weight", "50"),
+					resource.TestCheckResourceAttr(resourceName, "to_weight", "50"),
+				),
+			},
+			{
+				Config: createTrafficShiftTerraform(&TrafficShiftConfigMetadata{
+					name:  trafficShiftName,
+					steps: "[50, 100]",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "steps.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "from_weight", "0"),
+					resource.TestCheckResourceAttr(resourceName, "to_weight", "100"),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineTrafficShiftConfig = `
+resource "spotinst_multai_balancer" "foo" {
+  provider = "aws"
+  name     = "test-acc-foo"
+
+  connection_timeouts {
+    idle     = 10
+    draining = 10
+  }
+}
+
+resource "spotinst_multai_target_set" "blue" {
+  provider      = "aws"
+  balancer_id   = "${spotinst_multai_balancer.foo.id}"
+  deployment_id = "dp-12345"
+  name          = "test-acc-blue"
+  protocol      = "http"
+  port          = 1337
+  weight        = 100
+
+  health_check {
+    protocol            = "http"
+    path                = "/"
+    port                = 3000
+    interval            = 20
+    timeout             = 5
+    healthy_threshold   = 3
+    unhealthy_threshold = 3
+  }
+
+  lifecycle {
+    ignore_changes = ["weight"]
+  }
+}
+
+resource "spotinst_multai_target_set" "green" {
+  provider      = "aws"
+  balancer_id   = "${spotinst_multai_balancer.foo.id}"
+  deployment_id = "dp-12345"
+  name          = "test-acc-green"
+  protocol      = "http"
+  port          = 1337
+  weight        = 0
+
+  health_check {
+    protocol            = "http"
+    path                = "/"
+    port                = 3000
+    interval            = 20
+    timeout             = 5
+    healthy_threshold   = 3
+    unhealthy_threshold = 3
+  }
+
+  lifecycle {
+    ignore_changes = ["weight"]
+  }
+}
+
+resource "spotinst_multai_target" "green" {
+  provider      = "aws"
+  name          = "test-acc-green"
+  balancer_id   = "${spotinst_multai_balancer.foo.id}"
+  target_set_id = "${spotinst_multai_target_set.green.id}"
+  host          = "host"
+  port          = 1337
+  weight        = 1
+}
+
+resource "` + string(commons.MultaiTrafficShiftResourceName) + `" "%v" {
+  provider           = "%v"
+  from_target_set_id = "${spotinst_multai_target_set.blue.id}"
+  to_target_set_id   = "${spotinst_multai_target.green.target_set_id}"
+  steps              = %v
+
+  step_interval            = 10
+  health_check_interval    = 5
+  max_unhealthy_percentage = 100
+}`
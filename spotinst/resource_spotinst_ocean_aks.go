@@ -173,15 +173,15 @@ func resourceSpotinstClusterAKSUpdate(resourceData *schema.ResourceData, meta in
 	clusterID := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.OceanAKSResource.GetName(), clusterID)
 
-	shouldUpdate, cluster, err := commons.OceanAKSResource.OnUpdate(resourceData, meta)
+	changeset, cluster, err := commons.OceanAKSResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		cluster.SetId(spotinst.String(clusterID))
 		if err := updateAKSCluster(cluster, meta.(*Client)); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure"
@@ -25,7 +26,7 @@ func resourceSpotinstOceanAKSVirtualNodeGroup() *schema.Resource {
 		Delete: resourceSpotinstOceanAKSVirtualNodeGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceSpotinstOceanAKSVirtualNodeGroupImportState,
 		},
 
 		Schema: commons.OceanAKSVirtualNodeGroupResource.GetSchemaMap(),
@@ -143,15 +144,15 @@ func resourceSpotinstOceanAKSVirtualNodeGroupUpdate(resourceData *schema.Resourc
 	virtualNodeGroupID := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.OceanAKSVirtualNodeGroupResource.GetName(), virtualNodeGroupID)
 
-	shouldUpdate, virtualNodeGroup, err := commons.OceanAKSVirtualNodeGroupResource.OnUpdate(resourceData, meta)
+	changeset, virtualNodeGroup, err := commons.OceanAKSVirtualNodeGroupResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		virtualNodeGroup.SetId(spotinst.String(virtualNodeGroupID))
 		if err = updateAKSVirtualNodeGroup(context.TODO(), virtualNodeGroup, meta.(*Client)); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 
@@ -213,3 +214,55 @@ func deleteAKSVirtualNodeGroup(ctx context.Context, resourceData *schema.Resourc
 }
 
 // endregion
+
+// region Import
+func resourceSpotinstOceanAKSVirtualNodeGroupImportState(resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	importID := resourceData.Id()
+	log.Printf("===> Importing virtualNodeGroup AKS: %s <===", importID)
+
+	// A plain ID is passed through as is, the state is then filled by Read.
+	if !strings.Contains(importID, "/") {
+		return []*schema.ResourceData{resourceData}, nil
+	}
+
+	parts := strings.SplitN(importID, "/", 2)
+	if parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid AKS virtualNodeGroup import ID %q, expected <ocean_id>/<virtual_node_group_name>", importID)
+	}
+
+	virtualNodeGroupID, err := findAKSVirtualNodeGroupIDByName(parts[0], parts[1], meta.(*Client))
+	if err != nil {
+		return nil, err
+	}
+
+	resourceData.SetId(virtualNodeGroupID)
+	return []*schema.ResourceData{resourceData}, nil
+}
+
+func findAKSVirtualNodeGroupIDByName(oceanID, name string, spotinstClient *Client) (string, error) {
+	input := &azure.ListVirtualNodeGroupsInput{OceanID: spotinst.String(oceanID)}
+
+	resp, err := spotinstClient.ocean.CloudProviderAzure().ListVirtualNodeGroups(context.Background(), input)
+	if err != nil {
+		return "", fmt.Errorf("failed to list AKS virtualNodeGroups: %s", err)
+	}
+
+	var matches []string
+	for _, vng := range resp.VirtualNodeGroups {
+		if vng != nil && spotinst.StringValue(vng.Name) == name {
+			matches = append(matches, spotinst.StringValue(vng.ID))
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("AKS virtualNodeGroup %q not found in cluster %s", name, oceanID)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("found %d AKS virtualNodeGroups named %q in cluster %s (%s), import by ID instead",
+			len(matches), name, oceanID, strings.Join(matches, ", "))
+	}
+}
+
+// endregion
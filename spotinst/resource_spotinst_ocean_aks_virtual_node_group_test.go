@@ -0,0 +1,154 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createOceanAKSVirtualNodeGroupResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.OceanAKSVirtualNodeGroupResourceName), name)
+}
+
+func testOceanAKSVirtualNodeGroupDestroy(s *terraform.State) error {
+	client := testAccProviderAzure.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.OceanAKSVirtualNodeGroupResourceName) {
+			continue
+		}
+
+		input := &azure.ReadVirtualNodeGroupInput{VirtualNodeGroupID: spotinst.String(rs.Primary.ID)}
+		resp, err := client.ocean.CloudProviderAzure().ReadVirtualNodeGroup(context.Background(), input)
+
+		if err == nil && resp != nil && resp.VirtualNodeGroup != nil {
+			return fmt.Errorf("virtualNodeGroup still exists")
+		}
+	}
+
+	return nil
+}
+
+func testCheckOceanAKSVirtualNodeGroupAttributes(virtualNodeGroup *azure.VirtualNodeGroup, expectedID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if spotinst.StringValue(virtualNodeGroup.OceanID) != expectedID {
+			return fmt.Errorf("bad content: %v", virtualNodeGroup.OceanID)
+		}
+		return nil
+	}
+}
+
+func testCheckOceanAKSVirtualNodeGroupExists(virtualNodeGroup *azure.VirtualNodeGroup, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		client := testAccProviderAzure.Meta().(*Client)
+		input := &azure.ReadVirtualNodeGroupInput{VirtualNodeGroupID: spotinst.String(rs.Primary.ID)}
+		resp, err := client.ocean.CloudProviderAzure().ReadVirtualNodeGroup(context.Background(), input)
+		if err != nil {
+			return err
+		}
+		if spotinst.StringValue(resp.VirtualNodeGroup.ID) != rs.Primary.Attributes["id"] {
+			return fmt.Errorf("VirtualNodeGroup not found: %+v,\n %+v\n", resp.VirtualNodeGroup, rs.Primary.Attributes)
+		}
+		*virtualNodeGroup = *resp.VirtualNodeGroup
+		return nil
+	}
+}
+
+type AKSVirtualNodeGroupConfigMetadata struct {
+	provider string
+	name     string
+	oceanID  string
+}
+
+func createOceanAKSVirtualNodeGroupTerraform(vngcm *AKSVirtualNodeGroupConfigMetadata, formatToUse string) string {
+	if vngcm == nil {
+		return ""
+	}
+
+	if vngcm.provider == "" {
+		vngcm.provider = "azure"
+	}
+
+	template :=
+		`provider "azure" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	template += fmt.Sprintf(formatToUse,
+		vngcm.name,
+		vngcm.provider,
+		vngcm.name,
+		vngcm.oceanID,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", vngcm.name, template)
+	return template
+}
+
+// region OceanAKSVirtualNodeGroup: Import
+func TestAccSpotinstOceanAKSVirtualNodeGroup_Import(t *testing.T) {
+	oceanID := "o-1f4b6f6b"
+	name := "test-acc-vng-import"
+	resourceName := createOceanAKSVirtualNodeGroupResourceName(name)
+
+	var virtualNodeGroup azure.VirtualNodeGroup
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "azure") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanAKSVirtualNodeGroupDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanAKSVirtualNodeGroupTerraform(&AKSVirtualNodeGroupConfigMetadata{name: name, oceanID: oceanID}, testImportOceanAKSVirtualNodeGroupConfig_Create),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAKSVirtualNodeGroupExists(&virtualNodeGroup, resourceName),
+					testCheckOceanAKSVirtualNodeGroupAttributes(&virtualNodeGroup, oceanID),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", oceanID, name),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testImportOceanAKSVirtualNodeGroupConfig_Create = `
+resource "` + string(commons.OceanAKSVirtualNodeGroupResourceName) + `" "%v" {
+ provider = "%v"
+
+ name     = "%v"
+ ocean_id = "%v"
+
+ label {
+   key   = "label_key"
+   value = "label_value"
+ }
+}
+`
+
+// endregion
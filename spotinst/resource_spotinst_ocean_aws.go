@@ -34,6 +34,8 @@ func resourceSpotinstOceanAWS() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 		Schema: commons.OceanAWSResource.GetSchemaMap(),
+
+		CustomizeDiff: ocean_aws_scheduling.ValidateTimeWindows,
 	}
 }
 
@@ -154,15 +156,15 @@ func resourceSpotinstClusterAWSUpdate(resourceData *schema.ResourceData, meta in
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.OceanAWSResource.GetName(), id)
 
-	shouldUpdate, cluster, err := commons.OceanAWSResource.OnUpdate(resourceData, meta)
+	changeset, cluster, err := commons.OceanAWSResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		cluster.SetId(spotinst.String(id))
 		if err := updateAWSCluster(cluster, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 	log.Printf("===> Cluster updated successfully: %s <===", id)
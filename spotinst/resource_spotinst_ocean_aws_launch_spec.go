@@ -143,15 +143,15 @@ func resourceSpotinstOceanAWSLaunchSpecUpdate(resourceData *schema.ResourceData,
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.OceanAWSLaunchSpecResource.GetName(), id)
 
-	shouldUpdate, launchSpec, err := commons.OceanAWSLaunchSpecResource.OnUpdate(resourceData, meta)
+	changeset, launchSpec, err := commons.OceanAWSLaunchSpecResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		launchSpec.SetId(spotinst.String(id))
 		if err := updateLaunchSpec(launchSpec, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 	log.Printf("===> launchSpec updated successfully: %s <===", id)
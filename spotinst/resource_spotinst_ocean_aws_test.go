@@ -12,7 +12,6 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
-	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_launch_configuration"
 )
 
 func init() {
@@ -389,7 +388,7 @@ func TestAccSpotinstOceanAWS_LaunchConfiguration(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "security_groups.0", "sg-a2bce9fa"),
 					resource.TestCheckResourceAttr(resourceName, "associate_public_ip_address", "false"),
 					//resource.TestCheckResourceAttr(resourceName, "key_name", "my-key.ssh"),
-					resource.TestCheckResourceAttr(resourceName, "user_data", ocean_aws_launch_configuration.Base64StateFunc("echo hello world")),
+					resource.TestCheckResourceAttr(resourceName, "user_data", commons.UserDataStateFunc("echo hello world")),
 					//resource.TestCheckResourceAttr(resourceName, "iam_instance_profile", "iam-profile"),
 					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.1116605596.key", "fakeKey"),
@@ -422,7 +421,7 @@ func TestAccSpotinstOceanAWS_LaunchConfiguration(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "security_groups.0", "sg-a2bce9fa"),
 					resource.TestCheckResourceAttr(resourceName, "associate_public_ip_address", "true"),
 					//resource.TestCheckResourceAttr(resourceName, "key_name", "my-key-updated.ssh"),
-					resource.TestCheckResourceAttr(resourceName, "user_data", ocean_aws_launch_configuration.Base64StateFunc("echo hello world updated")),
+					resource.TestCheckResourceAttr(resourceName, "user_data", commons.UserDataStateFunc("echo hello world updated")),
 					//resource.TestCheckResourceAttr(resourceName, "iam_instance_profile", "iam-profile updated"),
 					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.3418058476.key", "fakeKeyUpdated"),
@@ -653,13 +652,14 @@ func TestAccSpotinstOceanAWS_Scheduling(t *testing.T) {
 					testCheckOceanAWSExists(&cluster, resourceName),
 					testCheckOceanAWSAttributes(&cluster, clusterName),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2055365918.shutdown_hours.0.is_enabled", "false"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2055365918.shutdown_hours.0.time_windows.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2055365918.shutdown_hours.0.time_windows.0", "Fri:15:30-Sat:15:30"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2055365918.tasks.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2055365918.tasks.0.cron_expression", "testcron2"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2055365918.tasks.0.is_enabled", "false"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2055365918.tasks.0.task_type", "clusterRoll"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.shutdown_hours.0.is_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.shutdown_hours.0.time_windows.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.shutdown_hours.0.time_windows.223060216", "Fri:15:30-Sat:15:30"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.shutdown_hours.0.weekly_off_hours", "24"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.tasks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.tasks.0.cron_expression", "0 1 * * *"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.tasks.0.is_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.tasks.0.task_type", "clusterRoll"),
 				),
 			},
 			{
@@ -672,14 +672,15 @@ func TestAccSpotinstOceanAWS_Scheduling(t *testing.T) {
 					testCheckOceanAWSExists(&cluster, resourceName),
 					testCheckOceanAWSAttributes(&cluster, clusterName),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.shutdown_hours.0.is_enabled", "true"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.shutdown_hours.0.time_windows.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.shutdown_hours.0.time_windows.0", "Fri:15:30-Sat:13:30"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.shutdown_hours.0.time_windows.1", "Sun:15:30-Mon:13:30"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.tasks.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.tasks.0.cron_expression", "testcron"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.tasks.0.is_enabled", "true"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.tasks.0.task_type", "clusterRoll"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.shutdown_hours.0.is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.shutdown_hours.0.time_windows.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.shutdown_hours.0.time_windows.673251108", "Fri:15:30-Sat:13:30"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.shutdown_hours.0.time_windows.1987213290", "Sun:15:30-Mon:13:30"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.shutdown_hours.0.weekly_off_hours", "44"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.tasks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.tasks.0.cron_expression", "0 2 * * *"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.tasks.0.is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.tasks.0.task_type", "clusterRoll"),
 				),
 			},
 			{
@@ -707,7 +708,7 @@ const testSchedulingConfig_Create = `
     }
     tasks {
       is_enabled = false
-      cron_expression = "testcron2"
+      cron_expression = "0 1 * * *"
       task_type = "clusterRoll"
     }
   }
@@ -723,7 +724,7 @@ const testSchedulingConfig_Update = `
     }
     tasks  {
       is_enabled = true
-      cron_expression = "testcron"
+      cron_expression = "0 2 * * *"
       task_type = "clusterRoll"
     }
   }
@@ -36,6 +36,8 @@ func resourceSpotinstOceanECS() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 		Schema: commons.OceanECSResource.GetSchemaMap(),
+
+		CustomizeDiff: ocean_ecs_scheduling.ValidateTimeWindows,
 	}
 }
 
@@ -156,15 +158,15 @@ func resourceSpotinstClusterECSUpdate(resourceData *schema.ResourceData, meta in
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.OceanAWSResource.GetName(), id)
 
-	shouldUpdate, cluster, err := commons.OceanECSResource.OnUpdate(resourceData, meta)
+	changeset, cluster, err := commons.OceanECSResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		cluster.SetId(spotinst.String(id))
 		if err := updateECSCluster(cluster, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 	log.Printf("===> Cluster updated successfully: %s <===", id)
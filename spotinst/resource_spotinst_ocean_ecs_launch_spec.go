@@ -133,15 +133,15 @@ func resourceSpotinstOceanECSLaunchSpecRead(resourceData *schema.ResourceData, m
 func resourceSpotinstOceanECSLaunchSpecUpdate(resourceData *schema.ResourceData, meta interface{}) error {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.OceanECSLaunchSpecResource.GetName(), id)
-	shouldUpdate, launchSpec, err := commons.OceanECSLaunchSpecResource.OnUpdate(resourceData, meta)
+	changeset, launchSpec, err := commons.OceanECSLaunchSpecResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		launchSpec.SetId(spotinst.String(id))
 		if err := updateECSLaunchSpec(launchSpec, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 	log.Printf("===> launchSpec updated successfully: %s <===", id)
@@ -353,7 +353,7 @@ func TestAccSpotinstOceanECS_LaunchSpecification(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "image_id", "ami-082b5a644766e0e6f"),
 					resource.TestCheckResourceAttr(resourceName, "iam_instance_profile", "arn:aws:iam::842422002533:instance-profile/ecsInstanceRole"),
 					resource.TestCheckResourceAttr(resourceName, "key_pair", "spotinst-labs-oregon"),
-					resource.TestCheckResourceAttr(resourceName, "user_data", "IyEvYmluL2Jhc2gKZWNobyBFQ1NfQ0xVU1RFUj1vcmZyb21FbnZpcm9ubWVudF9CYXRjaF84NTJhNjcwYS1hYTczLTNkNWQtOTU3Ni0xNDdhMjZkNDM0MDEgPj4gL2V0Yy9lY3MvZWNzLmNvbmZpZw=="),
+					resource.TestCheckResourceAttr(resourceName, "user_data", commons.UserDataStateFunc("IyEvYmluL2Jhc2gKZWNobyBFQ1NfQ0xVU1RFUj1vcmZyb21FbnZpcm9ubWVudF9CYXRjaF84NTJhNjcwYS1hYTczLTNkNWQtOTU3Ni0xNDdhMjZkNDM0MDEgPj4gL2V0Yy9lY3MvZWNzLmNvbmZpZw==")),
 					resource.TestCheckResourceAttr(resourceName, "associate_public_ip_address", "false"),
 					resource.TestCheckResourceAttr(resourceName, "block_device_mappings.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "block_device_mappings.0.device_name", "/dev/xvda1"),
@@ -381,7 +381,7 @@ func TestAccSpotinstOceanECS_LaunchSpecification(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "image_id", "ami-0f2176987ee50226e"),
 					resource.TestCheckResourceAttr(resourceName, "iam_instance_profile", "arn:aws:iam::842422002533:instance-profile/ecsInstanceRole"),
 					resource.TestCheckResourceAttr(resourceName, "key_pair", ""),
-					resource.TestCheckResourceAttr(resourceName, "user_data", "IyEvYmluL2Jhc2gKZWNobyBFQ1NfQ0xVU1RFUj1vcmZyb21FbnZpcm9ubWVudF9CYXRjaF84NTJhNjcwYS1hYTczLTNkNWQtOTU3Ni0xNDdhMjZkNDM0MDEgPj4gL2V0Yy9lY3MvZWNzLmNvbmZpZw=="),
+					resource.TestCheckResourceAttr(resourceName, "user_data", commons.UserDataStateFunc("IyEvYmluL2Jhc2gKZWNobyBFQ1NfQ0xVU1RFUj1vcmZyb21FbnZpcm9ubWVudF9CYXRjaF84NTJhNjcwYS1hYTczLTNkNWQtOTU3Ni0xNDdhMjZkNDM0MDEgPj4gL2V0Yy9lY3MvZWNzLmNvbmZpZw==")),
 					resource.TestCheckResourceAttr(resourceName, "associate_public_ip_address", "true"),
 					resource.TestCheckResourceAttr(resourceName, "block_device_mappings.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "block_device_mappings.0.device_name", "/dev/xvda1"),
@@ -683,13 +683,14 @@ func TestAccSpotinstoceanECS_Scheduling(t *testing.T) {
 					testCheckOceanECSExists(&cluster, resourceName),
 					testCheckOceanECSAttributes(&cluster, name),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2055365918.shutdown_hours.0.is_enabled", "false"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2055365918.shutdown_hours.0.time_windows.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2055365918.shutdown_hours.0.time_windows.0", "Fri:15:30-Sat:15:30"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2055365918.tasks.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2055365918.tasks.0.cron_expression", "testcron2"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2055365918.tasks.0.is_enabled", "false"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2055365918.tasks.0.task_type", "clusterRoll"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.shutdown_hours.0.is_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.shutdown_hours.0.time_windows.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.shutdown_hours.0.time_windows.223060216", "Fri:15:30-Sat:15:30"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.shutdown_hours.0.weekly_off_hours", "24"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.tasks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.tasks.0.cron_expression", "0 1 * * *"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.tasks.0.is_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2756648468.tasks.0.task_type", "clusterRoll"),
 				),
 			},
 			{
@@ -703,14 +704,15 @@ func TestAccSpotinstoceanECS_Scheduling(t *testing.T) {
 					testCheckOceanECSExists(&cluster, resourceName),
 					testCheckOceanECSAttributes(&cluster, name),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.shutdown_hours.0.is_enabled", "true"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.shutdown_hours.0.time_windows.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.shutdown_hours.0.time_windows.0", "Fri:15:30-Sat:13:30"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.shutdown_hours.0.time_windows.1", "Sun:15:30-Mon:13:30"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.tasks.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.tasks.0.cron_expression", "testcron"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.tasks.0.is_enabled", "true"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.161750964.tasks.0.task_type", "clusterRoll"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.shutdown_hours.0.is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.shutdown_hours.0.time_windows.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.shutdown_hours.0.time_windows.673251108", "Fri:15:30-Sat:13:30"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.shutdown_hours.0.time_windows.1987213290", "Sun:15:30-Mon:13:30"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.shutdown_hours.0.weekly_off_hours", "44"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.tasks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.tasks.0.cron_expression", "0 2 * * *"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.tasks.0.is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.3237950861.tasks.0.task_type", "clusterRoll"),
 				),
 			},
 			{
@@ -739,7 +741,7 @@ const testScheduling_Create = `
     }
     tasks {
       is_enabled = false
-      cron_expression = "testcron2"
+      cron_expression = "0 1 * * *"
       task_type = "clusterRoll"
     }
   }
@@ -755,7 +757,7 @@ const testScheduling_Update = `
     }
     tasks  {
       is_enabled = true
-      cron_expression = "testcron"
+      cron_expression = "0 2 * * *"
       task_type = "clusterRoll"
     }
   }
@@ -136,15 +136,15 @@ func resourceSpotinstClusterGKEUpdate(resourceData *schema.ResourceData, meta in
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.OceanGKEResource.GetName(), id)
 
-	shouldUpdate, cluster, err := commons.OceanGKEResource.OnUpdate(resourceData, meta)
+	changeset, cluster, err := commons.OceanGKEResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		cluster.SetId(spotinst.String(id))
 		if err := updateGKECluster(cluster, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 	log.Printf("===> Cluster updated successfully: %s <===", id)
@@ -31,6 +31,8 @@ func resourceSpotinstOceanGKEImport() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 		Schema: commons.OceanGKEImportResource.GetSchemaMap(),
+
+		CustomizeDiff: ocean_gke_import_scheduling.ValidateTimeWindows,
 	}
 }
 
@@ -177,15 +179,15 @@ func resourceSpotinstClusterGKEImportUpdate(resourceData *schema.ResourceData, m
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.OceanGKEImportResource.GetName(), id)
 
-	shouldUpdate, cluster, err := commons.OceanGKEImportResource.OnUpdate(resourceData, meta)
+	changeset, cluster, err := commons.OceanGKEImportResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		cluster.SetId(spotinst.String(id))
 		if err := updateGKEImportCluster(cluster, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 	log.Printf("===> GLE Cluster updated successfully: %s <===", id)
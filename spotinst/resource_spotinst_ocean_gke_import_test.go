@@ -329,14 +329,15 @@ func TestAccSpotinstOceanGKEImport_Scheduling(t *testing.T) {
 					testCheckOceanGKEImportExists(&cluster, resourceName),
 					testCheckOceanGKEImportAttributes(&cluster, GcpClusterName),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137025357.shutdown_hours.0.is_enabled", "true"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137025357.shutdown_hours.0.time_windows.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137025357.shutdown_hours.0.time_windows.0", "Fri:15:30-Sat:17:30"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137025357.tasks.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137025357.tasks.0.cron_expression", "0 1 1 * *"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137025357.tasks.0.is_enabled", "true"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137025357.tasks.0.task_type", "clusterRoll"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137025357.tasks.0.batch_size_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134179729.shutdown_hours.0.is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134179729.shutdown_hours.0.time_windows.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134179729.shutdown_hours.0.time_windows.2806147187", "Fri:15:30-Sat:17:30"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134179729.shutdown_hours.0.weekly_off_hours", "26"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134179729.tasks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134179729.tasks.0.cron_expression", "0 1 1 * *"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134179729.tasks.0.is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134179729.tasks.0.task_type", "clusterRoll"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134179729.tasks.0.batch_size_percentage", "50"),
 				),
 			},
 			{
@@ -349,14 +350,15 @@ func TestAccSpotinstOceanGKEImport_Scheduling(t *testing.T) {
 					testCheckOceanGKEImportExists(&cluster, resourceName),
 					testCheckOceanGKEImportAttributes(&cluster, GcpClusterName),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134430294.shutdown_hours.0.is_enabled", "false"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134430294.shutdown_hours.0.time_windows.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134430294.shutdown_hours.0.time_windows.0", "Fri:15:30-Sat:18:30"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134430294.tasks.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134430294.tasks.0.cron_expression", "0 1 * * *"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134430294.tasks.0.is_enabled", "false"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134430294.tasks.0.task_type", "clusterRoll"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.2134430294.tasks.0.batch_size_percentage", "20"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137282186.shutdown_hours.0.is_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137282186.shutdown_hours.0.time_windows.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137282186.shutdown_hours.0.time_windows.4280383525", "Fri:15:30-Sat:18:30"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137282186.shutdown_hours.0.weekly_off_hours", "27"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137282186.tasks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137282186.tasks.0.cron_expression", "0 1 * * *"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137282186.tasks.0.is_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137282186.tasks.0.task_type", "clusterRoll"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.1137282186.tasks.0.batch_size_percentage", "20"),
 				),
 			},
 		},
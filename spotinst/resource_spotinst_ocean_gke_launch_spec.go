@@ -3,8 +3,8 @@ package spotinst
 import (
 	"context"
 	"fmt"
-
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
@@ -25,7 +25,7 @@ func resourceSpotinstOceanGKELaunchSpec() *schema.Resource {
 		Delete: resourceSpotinstOceanGKELaunchSpecDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceSpotinstOceanGKELaunchSpecImportState,
 		},
 
 		Schema: commons.OceanGKELaunchSpecResource.GetSchemaMap(),
@@ -132,15 +132,15 @@ func resourceSpotinstOceanGKELaunchSpecUpdate(resourceData *schema.ResourceData,
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.OceanGKELaunchSpecResource.GetName(), id)
 
-	shouldUpdate, launchSpec, err := commons.OceanGKELaunchSpecResource.OnUpdate(resourceData, meta)
+	changeset, launchSpec, err := commons.OceanGKELaunchSpecResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		launchSpec.SetId(spotinst.String(id))
 		if err := updateGKELaunchSpec(launchSpec, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 	log.Printf("===> launchSpec GKE updated successfully: %s <===", id)
@@ -227,3 +227,59 @@ func importGKELaunchSpec(resourceData *schema.ResourceData, meta interface{}) (*
 }
 
 //endregion
+
+//region Import Existing Launch Spec
+func resourceSpotinstOceanGKELaunchSpecImportState(resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	importID := resourceData.Id()
+	log.Printf("===> Importing launchSpec GKE: %s <===", importID)
+
+	if err := resourceData.Set(string(ocean_gke_launch_spec.Imported), true); err != nil {
+		return nil, fmt.Errorf(string(commons.FailureFieldReadPattern), string(ocean_gke_launch_spec.Imported), err)
+	}
+
+	// A plain ID is passed through as is, the state is then filled by Read.
+	if !strings.Contains(importID, "/") {
+		return []*schema.ResourceData{resourceData}, nil
+	}
+
+	parts := strings.SplitN(importID, "/", 2)
+	if parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid GKE launchSpec import ID %q, expected <ocean_id>/<launch_spec_name>", importID)
+	}
+
+	launchSpecID, err := findGKELaunchSpecIDByName(parts[0], parts[1], meta.(*Client))
+	if err != nil {
+		return nil, err
+	}
+
+	resourceData.SetId(launchSpecID)
+	return []*schema.ResourceData{resourceData}, nil
+}
+
+func findGKELaunchSpecIDByName(oceanID, name string, spotinstClient *Client) (string, error) {
+	input := &gcp.ListLaunchSpecsInput{OceanID: spotinst.String(oceanID)}
+
+	resp, err := spotinstClient.ocean.CloudProviderGCP().ListLaunchSpecs(context.Background(), input)
+	if err != nil {
+		return "", fmt.Errorf("failed to list GKE launchSpecs: %s", err)
+	}
+
+	var matches []string
+	for _, ls := range resp.LaunchSpecs {
+		if ls != nil && spotinst.StringValue(ls.Name) == name {
+			matches = append(matches, spotinst.StringValue(ls.ID))
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("GKE launchSpec %q not found in cluster %s", name, oceanID)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("found %d GKE launchSpecs named %q in cluster %s (%s), import by ID instead",
+			len(matches), name, oceanID, strings.Join(matches, ", "))
+	}
+}
+
+//endregion
@@ -123,15 +123,15 @@ func resourceSpotinstOceanGKELaunchSpecImportUpdate(resourceData *schema.Resourc
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.OceanGKELaunchSpecImportResource.GetName(), id)
 
-	shouldUpdate, launchSpec, err := commons.OceanGKELaunchSpecImportResource.OnUpdate(resourceData, meta)
+	changeset, launchSpec, err := commons.OceanGKELaunchSpecImportResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		launchSpec.SetId(spotinst.String(id))
 		if err := updateGKELaunchSpecImport(launchSpec, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 	log.Printf("===> launchSpec GKE updated successfully: %s <===", id)
@@ -776,3 +776,46 @@ resource "` + string(commons.OceanGKELaunchSpecResourceName) + `" "%v" {
 `
 
 //endregion
+
+// region OceanGKELaunchSpec: Import
+func TestAccSpotinstOceanGKELaunchSpec_Import(t *testing.T) {
+	oceanID := "o-2244b135"
+	resourceName := createOceanGKELaunchSpecResource(oceanID)
+
+	var launchSpec gcp.LaunchSpec
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "gcp") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanGKELaunchSpecDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanGKELaunchSpecTerraform(&GKELaunchSpecConfigMetadata{oceanID: oceanID}, testLabelsOceanGKELaunchSpecConfig_Create),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKELaunchSpecExists(&launchSpec, resourceName),
+					testCheckOceanGKELaunchSpecAttributes(&launchSpec, oceanID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"imported"},
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					if launchSpec.Name == nil {
+						return "", fmt.Errorf("launchSpec %s has no name", spotinst.StringValue(launchSpec.ID))
+					}
+					return fmt.Sprintf("%s/%s", oceanID, spotinst.StringValue(launchSpec.Name)), nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"imported"},
+			},
+		},
+	})
+}
+
+// endregion
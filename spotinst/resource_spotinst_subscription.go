@@ -107,15 +107,15 @@ func resourceSpotinstSubscriptionUpdate(resourceData *schema.ResourceData, meta
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.SubscriptionResource.GetName(), id)
 
-	shouldUpdate, sub, err := commons.SubscriptionResource.OnUpdate(resourceData, meta)
+	changeset, sub, err := commons.SubscriptionResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return err
 	}
 
-	if shouldUpdate {
+	if changeset.HasChanges() {
 		sub.SetId(spotinst.String(id))
 		if err := updateSubscription(sub, resourceData, meta); err != nil {
-			return err
+			return changeset.WrapError(err)
 		}
 	}
 